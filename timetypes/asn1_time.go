@@ -0,0 +1,126 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = ASN1Time{}
+	_ basetypes.StringValuable                   = ASN1Time{}
+	_ basetypes.StringValuableWithSemanticEquals = ASN1Time{}
+)
+
+// ASN1TimeNull returns a null ASN1Time.
+func ASN1TimeNull() ASN1Time {
+	return ASN1Time{
+		timestamp{
+			stringValue: stringValue{
+				null: true,
+			},
+		},
+	}
+}
+
+// ASN1TimeString returns a known ASN1Time or any errors while attempting to
+// parse the string as RFC 5280 UTCTime or GeneralizedTime format.
+func ASN1TimeString(s string, schemaPath path.Path) (ASN1Time, diag.Diagnostics) {
+	value, diags := timestampString(ASN1TimeType{}, s, schemaPath)
+
+	return ASN1Time{value}, diags
+}
+
+// ASN1TimeTime returns a known ASN1Time with the given time converted to UTC
+// and formatted as UTCTime for years 1950 through 2049 or GeneralizedTime
+// for other years. Fractional seconds are truncated.
+func ASN1TimeTime(t time.Time) ASN1Time {
+	return ASN1TimeType{}.ValueFromTime(t)
+}
+
+// ASN1TimeUnknown returns an unknown ASN1Time.
+func ASN1TimeUnknown() ASN1Time {
+	return ASN1Time{
+		timestamp{
+			stringValue: stringValue{
+				unknown: true,
+			},
+		},
+	}
+}
+
+// ASN1Time implements the attr.Value interface for usage in logic. It
+// represents an [RFC 5280] X.509 certificate validity time, such as
+// notBefore or notAfter, in the UTCTime or GeneralizedTime format.
+//
+// [RFC 5280]: https://www.rfc-editor.org/rfc/rfc5280#section-4.1.2.5
+type ASN1Time struct {
+	timestamp
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is an ASN1Time type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented instants instead.
+func (v ASN1Time) Equal(o attr.Value) bool {
+	otherValue, ok := o.(ASN1Time)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// IsGeneralizedTime returns true if a known ASN1Time uses the
+// GeneralizedTime format, YYYYMMDDHHMMSSZ, rather than the UTCTime format,
+// YYMMDDHHMMSSZ.
+func (v ASN1Time) IsGeneralizedTime() bool {
+	return len(v.valueString) == len(asn1GeneralizedTimeLayout)
+}
+
+// StringSemanticEquals returns true if the given ASN1Time represents the
+// same instant in time. Since RFC 5280 requires a single format for each
+// year, semantically equal values also have the same string representation.
+// The framework calls this method to keep the prior value and prevent
+// unexpected differences.
+func (v ASN1Time) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ASN1Time)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.value.Equal(newValue.value), diags
+}
+
+// ToRFC3339 converts the ASN1Time to an RFC3339 in UTC, such as
+// 2006-01-02T15:04:05Z. The conversion is lossless, since both formats have
+// whole seconds in UTC. An error diagnostic is returned if the year is after
+// 9999. A null or unknown ASN1Time returns a null or unknown RFC3339.
+func (v ASN1Time) ToRFC3339() (RFC3339, diag.Diagnostics) {
+	if v.null {
+		return RFC3339Null(), nil
+	}
+
+	if v.unknown {
+		return RFC3339Unknown(), nil
+	}
+
+	return RFC3339Time(v.value)
+}
+
+// Type returns the attr.Type of ASN1Time.
+func (v ASN1Time) Type(_ context.Context) attr.Type {
+	return ASN1TimeType{}
+}
@@ -0,0 +1,151 @@
+package timetypes
+
+import (
+	"time"
+)
+
+const (
+	// asn1UTCTimeLayout is the time package layout of the UTCTime format.
+	asn1UTCTimeLayout = "060102150405Z"
+
+	// asn1GeneralizedTimeLayout is the time package layout of the
+	// GeneralizedTime format.
+	asn1GeneralizedTimeLayout = "20060102150405Z"
+)
+
+// ParseASN1Time parses a string using the [RFC 5280 section 4.1.2.5] Time
+// rules for X.509 certificate validity. Years 1950 through 2049 must use the
+// UTCTime format, YYMMDDHHMMSSZ, where two digit years of 50 or greater are
+// 19YY and less than 50 are 20YY. Other years must use the GeneralizedTime
+// format, YYYYMMDDHHMMSSZ. Both formats must include seconds and the Z
+// suffix and must not include fractional seconds. The returned time is in
+// UTC.
+//
+// Any returned error is a *ParseError.
+//
+// [RFC 5280 section 4.1.2.5]: https://www.rfc-editor.org/rfc/rfc5280#section-4.1.2.5
+func ParseASN1Time(s string) (time.Time, error) {
+	p := &asn1TimeParser{
+		rfc3339Parser: rfc3339Parser{
+			format: "ASN.1 Time",
+			input:  s,
+		},
+	}
+
+	return p.asn1Time()
+}
+
+// asn1TimeParser is a parser for the RFC 5280 Time formats. It reuses the
+// character and digit handling of rfc3339Parser.
+type asn1TimeParser struct {
+	rfc3339Parser
+}
+
+// asn1Time parses: Time = utcTime / generalTime
+func (p *asn1TimeParser) asn1Time() (time.Time, error) {
+	// The formats are distinguished by the number of leading digits, which
+	// is 12 for UTCTime and 14 for GeneralizedTime.
+	digits := 0
+
+	for digits < len(p.input) && isDigit(p.input[digits]) {
+		digits++
+	}
+
+	if digits <= 12 {
+		return p.utcTime()
+	}
+
+	return p.generalizedTime()
+}
+
+// utcTime parses: UTCTime = YYMMDDHHMMSS "Z"
+func (p *asn1TimeParser) utcTime() (time.Time, error) {
+	year, err := p.digits("year", 2, 0, 99)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// RFC 5280 interprets two digit years of 50 or greater as 19YY and less
+	// than 50 as 20YY.
+	if year >= 50 {
+		year += 1900
+	} else {
+		year += 2000
+	}
+
+	return p.dateTime("UTCTime", year)
+}
+
+// generalizedTime parses: GeneralizedTime = YYYYMMDDHHMMSS "Z"
+func (p *asn1TimeParser) generalizedTime() (time.Time, error) {
+	year, err := p.digits("year", 4, 0, 9999)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if year >= 1950 && year <= 2049 {
+		p.offset = 0
+
+		return time.Time{}, p.errorf("GeneralizedTime", "UTCTime, YYMMDDHHMMSSZ, for years 1950 through 2049")
+	}
+
+	return p.dateTime("GeneralizedTime", year)
+}
+
+// dateTime parses the MMDDHHMMSS "Z" remainder of both formats.
+func (p *asn1TimeParser) dateTime(component string, year int) (time.Time, error) {
+	month, err := p.digits("month", 2, 1, 12)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	day, err := p.digits("day", 2, 1, daysIn(year, month))
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	hour, err := p.digits("hour", 2, 0, 23)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	minute, err := p.digits("minute", 2, 0, 59)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	second, err := p.digits("second", 2, 0, 59)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char(component, "Z", 'Z'); err != nil {
+		return time.Time{}, err
+	}
+
+	if p.offset != len(p.input) {
+		return time.Time{}, p.errorf(component, "end of string")
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC), nil
+}
+
+// formatASN1Time returns the time in UTC, truncated to seconds, and its
+// UTCTime string representation for years 1950 through 2049 or
+// GeneralizedTime string representation for other years.
+func formatASN1Time(t time.Time) (time.Time, string) {
+	t = t.UTC().Truncate(time.Second)
+
+	if t.Year() >= 1950 && t.Year() <= 2049 {
+		return t, t.Format(asn1UTCTimeLayout)
+	}
+
+	return t, t.Format(asn1GeneralizedTimeLayout)
+}
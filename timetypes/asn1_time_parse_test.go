@@ -0,0 +1,208 @@
+package timetypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseASN1Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      time.Time
+		expectedError *timetypes.ParseError
+	}{
+		// UTCTime strings.
+		"utctime-1950": {
+			input:    "500101000000Z",
+			expected: time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"utctime-2006": {
+			input:    "060102150405Z",
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"utctime-2049": {
+			input:    "491231235959Z",
+			expected: time.Date(2049, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		"utctime-feb-29-leap-year": {
+			input:    "040229000000Z",
+			expected: time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+
+		// GeneralizedTime strings.
+		"generalizedtime-1949": {
+			input:    "19491231235959Z",
+			expected: time.Date(1949, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		"generalizedtime-2050": {
+			input:    "20500101000000Z",
+			expected: time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"generalizedtime-9999": {
+			input:    "99991231235959Z",
+			expected: time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+
+		// Invalid strings.
+		"invalid-empty": {
+			input: "",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "",
+				Offset:    0,
+				Component: "year",
+				Expected:  "2 digits",
+			},
+		},
+		"invalid-generalizedtime-1950": {
+			input: "19500101000000Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "19500101000000Z",
+				Offset:    0,
+				Component: "GeneralizedTime",
+				Expected:  "UTCTime, YYMMDDHHMMSSZ, for years 1950 through 2049",
+			},
+		},
+		"invalid-generalizedtime-2049": {
+			input: "20491231235959Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "20491231235959Z",
+				Offset:    0,
+				Component: "GeneralizedTime",
+				Expected:  "UTCTime, YYMMDDHHMMSSZ, for years 1950 through 2049",
+			},
+		},
+		"invalid-generalizedtime-fractional-seconds": {
+			input: "20510102150405.5Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "20510102150405.5Z",
+				Offset:    14,
+				Component: "GeneralizedTime",
+				Expected:  `"Z"`,
+			},
+		},
+		"invalid-generalizedtime-offset": {
+			input: "20510102150405+0000",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "20510102150405+0000",
+				Offset:    14,
+				Component: "GeneralizedTime",
+				Expected:  `"Z"`,
+			},
+		},
+		"invalid-utctime-day-feb-29-non-leap-year": {
+			input: "050229000000Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "050229000000Z",
+				Offset:    4,
+				Component: "day",
+				Expected:  "01-28",
+			},
+		},
+		"invalid-utctime-hour": {
+			input: "060102240405Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "060102240405Z",
+				Offset:    6,
+				Component: "hour",
+				Expected:  "00-23",
+			},
+		},
+		"invalid-utctime-month": {
+			input: "061302150405Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "061302150405Z",
+				Offset:    2,
+				Component: "month",
+				Expected:  "01-12",
+			},
+		},
+		"invalid-utctime-second-leap-second": {
+			input: "051231235960Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "051231235960Z",
+				Offset:    10,
+				Component: "second",
+				Expected:  "00-59",
+			},
+		},
+		"invalid-utctime-second-missing": {
+			input: "0601021504Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "0601021504Z",
+				Offset:    10,
+				Component: "second",
+				Expected:  "2 digits",
+			},
+		},
+		"invalid-utctime-trailing-characters": {
+			input: "060102150405Z ",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "060102150405Z ",
+				Offset:    13,
+				Component: "UTCTime",
+				Expected:  "end of string",
+			},
+		},
+		"invalid-utctime-z-lowercase": {
+			input: "060102150405z",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "060102150405z",
+				Offset:    12,
+				Component: "UTCTime",
+				Expected:  `"Z"`,
+			},
+		},
+		"invalid-utctime-z-missing": {
+			input: "060102150405",
+			expectedError: &timetypes.ParseError{
+				Format:    "ASN.1 Time",
+				Input:     "060102150405",
+				Offset:    12,
+				Component: "UTCTime",
+				Expected:  `"Z"`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := timetypes.ParseASN1Time(testCase.input)
+
+			var parseErr *timetypes.ParseError
+
+			if err != nil && !errors.As(err, &parseErr) {
+				t.Fatalf("expected *timetypes.ParseError, got: %T", err)
+			}
+
+			if diff := cmp.Diff(parseErr, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
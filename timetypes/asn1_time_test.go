@@ -0,0 +1,516 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestASN1TimeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		other    attr.Value
+		expected bool
+	}{
+		"not-timetypes.ASN1Time": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			other:    types.StringValue("060102150405Z"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.ASN1TimeNull(),
+			other:    timetypes.ASN1TimeNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.ASN1TimeNull(),
+			other:    timetypes.ASN1TimeUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.ASN1TimeNull(),
+			other:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			other:    timetypes.ASN1TimeUnknown(),
+			expected: true,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			other:    testValue(t, timetypes.ASN1TimeString, "060102150406Z"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			other:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeIsGeneralizedTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: false,
+		},
+		"value-generalizedtime": {
+			value:    testValue(t, timetypes.ASN1TimeString, "20510102150405Z"),
+			expected: true,
+		},
+		"value-utctime": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsGeneralizedTime()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ASN1Time
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.ASN1Time": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			newValue: types.StringValue("060102150405Z"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ASN1Time\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			newValue: testValue(t, timetypes.ASN1TimeString, "060102150406Z"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			newValue: testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: true,
+		},
+		"value-value-generalizedtime": {
+			value:    testValue(t, timetypes.ASN1TimeString, "20510102150405Z"),
+			newValue: testValue(t, timetypes.ASN1TimeString, "20510102150405Z"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		expected string
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: "\"060102150405Z\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		expected time.Time
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: time.Time{},
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: time.Time{},
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"value-generalizedtime": {
+			value:    testValue(t, timetypes.ASN1TimeString, "19491231235959Z"),
+			expected: time.Date(1949, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Time()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestASN1TimeToRFC3339(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ASN1Time
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+		},
+		"value-generalizedtime": {
+			value:    testValue(t, timetypes.ASN1TimeString, "20510102150405Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2051-01-02T15:04:05Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToRFC3339()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ASN1Time
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: types.StringValue("060102150405Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		expected tftypes.Value
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: tftypes.NewValue(tftypes.String, "060102150405Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		expected attr.Type
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: timetypes.ASN1TimeType{},
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: timetypes.ASN1TimeType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestASN1TimeValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ASN1Time
+		expected string
+	}{
+		"null": {
+			value:    timetypes.ASN1TimeNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.ASN1TimeUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+			expected: "060102150405Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
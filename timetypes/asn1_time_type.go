@@ -0,0 +1,133 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = ASN1TimeType{}
+	_ attr.Type                    = ASN1TimeType{}
+	_ basetypes.StringTypable      = ASN1TimeType{}
+	_ xattr.TypeWithValidate       = ASN1TimeType{}
+
+	_ timestampFormatWithSuggestion = ASN1TimeType{}
+)
+
+// ASN1TimeType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are RFC 5280 UTCTime strings, such as
+// 060102150405Z, for years 1950 through 2049 and GeneralizedTime strings,
+// such as 20510102150405Z, for other years.
+type ASN1TimeType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t ASN1TimeType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is ASN1TimeType.
+func (t ASN1TimeType) Equal(o attr.Type) bool {
+	_, ok := o.(ASN1TimeType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t ASN1TimeType) String() string {
+	return "timetypes.ASN1TimeType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t ASN1TimeType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always RFC 5280 Time conformant.
+func (t ASN1TimeType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return timestampValidate(t, terraformValue, schemaPath)
+}
+
+// ValueFromString converts the types.String into a value.
+func (t ASN1TimeType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value, diags := timestampValueFromString(t, in)
+
+	return ASN1Time{value}, diags
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t ASN1TimeType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	value, err := timestampValueFromTerraform(t, terraformValue)
+
+	return ASN1Time{value}, err
+}
+
+// ValueFromTime returns a known ASN1Time with the given time converted to
+// UTC and formatted as UTCTime for years 1950 through 2049 or
+// GeneralizedTime for other years. Fractional seconds are truncated.
+func (t ASN1TimeType) ValueFromTime(value time.Time) ASN1Time {
+	return ASN1Time{timestampTime(t, value)}
+}
+
+// ValueType returns the associated attr.Value.
+func (t ASN1TimeType) ValueType(_ context.Context) attr.Value {
+	return ASN1Time{}
+}
+
+// describe returns the ASN.1 Time format description for diagnostics.
+func (t ASN1TimeType) describe() string {
+	return "The ASN.1 Time string format is UTCTime YYMMDDHHMMSSZ for years 1950 through 2049, such as 060102150405Z, " +
+		"or GeneralizedTime YYYYMMDDHHMMSSZ for other years, such as 20510102150405Z."
+}
+
+// format returns the time in UTC, truncated to seconds, and its string
+// representation.
+func (t ASN1TimeType) format(value time.Time) (time.Time, string) {
+	return formatASN1Time(value)
+}
+
+// name returns ASN.1 Time.
+func (t ASN1TimeType) name() string {
+	return "ASN.1 Time"
+}
+
+// parse parses the string with ParseASN1Time.
+func (t ASN1TimeType) parse(s string) (time.Time, error) {
+	return ParseASN1Time(s)
+}
+
+// suggestion returns the correctly encoded string for a GeneralizedTime
+// string in the years 1950 through 2049 or an RFC 3339 string with whole
+// seconds.
+func (t ASN1TimeType) suggestion(s string) string {
+	value, err := time.Parse(asn1GeneralizedTimeLayout, s)
+
+	if err != nil {
+		value, err = ParseRFC3339(s)
+	}
+
+	if err != nil || value.Nanosecond() != 0 {
+		return ""
+	}
+
+	_, suggestion := formatASN1Time(value)
+
+	if suggestion == s {
+		return ""
+	}
+
+	if _, err := ParseASN1Time(suggestion); err != nil {
+		return ""
+	}
+
+	return suggestion
+}
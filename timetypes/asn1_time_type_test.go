@@ -0,0 +1,508 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestASN1TimeTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.ASN1TimeType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.ASN1TimeType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.ASN1TimeType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.ASN1TimeType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.ASN1TimeType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.ASN1TimeType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.ASN1TimeType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.ASN1TimeType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.ASN1TimeType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ASN1TimeType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.ASN1TimeType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.ASN1TimeType": {
+			typ:      timetypes.ASN1TimeType{},
+			other:    timetypes.ASN1TimeType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.ASN1TimeType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.ASN1TimeType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ASN1TimeType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.ASN1TimeType{},
+			expected: "timetypes.ASN1TimeType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ASN1TimeType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.ASN1TimeType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.ASN1TimeType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 Time Terraform Value",
+					"An unexpected error occurred while attempting to read a ASN.1 Time string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-generalizedtime": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "20510102150405Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-generalizedtime-utctime-year": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "20060102150405Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ASN.1 Time format. "+
+						"The ASN.1 Time string format is UTCTime YYMMDDHHMMSSZ for years 1950 through 2049, such as 060102150405Z, "+
+						"or GeneralizedTime YYYYMMDDHHMMSSZ for other years, such as 20510102150405Z.\n\n"+
+						"Invalid GeneralizedTime at character 1, expected UTCTime, YYMMDDHHMMSSZ, for years 1950 through 2049:\n\n"+
+						"    20060102150405Z\n"+
+						"    ^\n\n"+
+						"Did you mean 060102150405Z?",
+				),
+			},
+		},
+		"string-value-invalid-month": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "061302150405Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ASN.1 Time format. "+
+						"The ASN.1 Time string format is UTCTime YYMMDDHHMMSSZ for years 1950 through 2049, such as 060102150405Z, "+
+						"or GeneralizedTime YYYYMMDDHHMMSSZ for other years, such as 20510102150405Z.\n\n"+
+						"Invalid month at character 3, expected 01-12:\n\n"+
+						"    061302150405Z\n"+
+						"      ^",
+				),
+			},
+		},
+		"string-value-rfc3339": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2051-01-02T15:04:05+07:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ASN.1 Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ASN.1 Time format. "+
+						"The ASN.1 Time string format is UTCTime YYMMDDHHMMSSZ for years 1950 through 2049, such as 060102150405Z, "+
+						"or GeneralizedTime YYYYMMDDHHMMSSZ for other years, such as 20510102150405Z.\n\n"+
+						"Invalid month at character 3, expected 01-12:\n\n"+
+						"    2051-01-02T15:04:05+07:00\n"+
+						"      ^\n\n"+
+						"Did you mean 20510102080405Z?",
+				),
+			},
+		},
+		"string-value-utctime": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "060102150405Z"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.ASN1TimeType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.ASN1TimeType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.ASN1TimeNull(),
+		},
+		"unknown": {
+			typ:         timetypes.ASN1TimeType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.ASN1TimeUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.ASN1TimeType{},
+			stringValue: types.StringValue("060102150405"),
+			expected:    timetypes.ASN1TimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid ASN.1 Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ASN.1 Time format. "+
+						"The ASN.1 Time string format is UTCTime YYMMDDHHMMSSZ for years 1950 through 2049, such as 060102150405Z, "+
+						"or GeneralizedTime YYYYMMDDHHMMSSZ for other years, such as 20510102150405Z.\n\n"+
+						"Invalid UTCTime at character 13, expected \"Z\":\n\n"+
+						"    060102150405\n"+
+						"                ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.ASN1TimeType{},
+			stringValue: types.StringValue("060102150405Z"),
+			expected:    testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.ASN1TimeType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.ASN1TimeUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.ASN1TimeNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.ASN1TimeUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "20060102150405Z"),
+			expected:       timetypes.ASN1TimeUnknown(),
+			expectedError:  fmt.Errorf(`parsing "20060102150405Z" as ASN.1 Time: invalid GeneralizedTime at offset 0: expected UTCTime, YYMMDDHHMMSSZ, for years 1950 through 2049`),
+		},
+		"string-value-valid": {
+			typ:            timetypes.ASN1TimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "060102150405Z"),
+			expected:       testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestASN1TimeTypeValueFromTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ASN1TimeType
+		value    time.Time
+		expected timetypes.ASN1Time
+	}{
+		"fractional-seconds": {
+			typ:      timetypes.ASN1TimeType{},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 999999999, time.UTC),
+			expected: testValue(t, timetypes.ASN1TimeString, "060102150405Z"),
+		},
+		"generalizedtime-1949": {
+			typ:      timetypes.ASN1TimeType{},
+			value:    time.Date(1949, 12, 31, 23, 59, 59, 0, time.UTC),
+			expected: testValue(t, timetypes.ASN1TimeString, "19491231235959Z"),
+		},
+		"generalizedtime-2050": {
+			typ:      timetypes.ASN1TimeType{},
+			value:    time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: testValue(t, timetypes.ASN1TimeString, "20500101000000Z"),
+		},
+		"offset": {
+			typ:      timetypes.ASN1TimeType{},
+			value:    time.Date(2050, 1, 1, 6, 0, 0, 0, time.FixedZone("", 7*60*60)),
+			expected: testValue(t, timetypes.ASN1TimeString, "491231230000Z"),
+		},
+		"utctime-1950": {
+			typ:      timetypes.ASN1TimeType{},
+			value:    time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: testValue(t, timetypes.ASN1TimeString, "500101000000Z"),
+		},
+		"utctime-2049": {
+			typ:      timetypes.ASN1TimeType{},
+			value:    time.Date(2049, 12, 31, 23, 59, 59, 0, time.UTC),
+			expected: testValue(t, timetypes.ASN1TimeString, "491231235959Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueFromTime(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if !got.Time().Equal(testCase.expected.Time()) {
+				t.Errorf("expected time %s, got: %s", testCase.expected.Time(), got.Time())
+			}
+		})
+	}
+}
+
+func TestASN1TimeTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ASN1TimeType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.ASN1TimeType{},
+			expected: timetypes.ASN1Time{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
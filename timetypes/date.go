@@ -0,0 +1,198 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value               = Date{}
+	_ basetypes.StringValuable = Date{}
+)
+
+// DateNull returns a null Date.
+func DateNull() Date {
+	return Date{
+		null: true,
+	}
+}
+
+// DateString returns a known Date or any errors while attempting to parse
+// the string as RFC 3339 full-date format.
+func DateString(s string, schemaPath path.Path) (Date, diag.Diagnostics) {
+	year, month, day, err := parseFullDate(s)
+
+	if err != nil {
+		return Date{
+				unknown: true,
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid Date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 full-date format. "+
+						"The RFC 3339 full-date string format is YYYY-MM-DD, such as 2006-01-02.\n\n"+
+						parseErrorDetail(err),
+				),
+			}
+	}
+
+	return Date{
+		year:  year,
+		month: month,
+		day:   day,
+	}, nil
+}
+
+// DateTime returns a known Date with the calendar date of the given time in
+// its location or an error diagnostic if the year is outside 0000 to 9999.
+func DateTime(t time.Time) (Date, diag.Diagnostics) {
+	year, month, day := t.Date()
+
+	if year < 0 || year > 9999 {
+		return DateUnknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Date Conversion Error",
+				"An unexpected error occurred while converting a time to a Date. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Time "+t.Format(time.RFC3339Nano)+" is outside the years 0000 to 9999, which RFC 3339 full-date cannot represent.",
+			),
+		}
+	}
+
+	return Date{
+		year:  year,
+		month: month,
+		day:   day,
+	}, nil
+}
+
+// DateUnknown returns an unknown Date.
+func DateUnknown() Date {
+	return Date{
+		unknown: true,
+	}
+}
+
+// Date implements the attr.Value interface for usage in logic. It represents
+// a calendar date without any time zone.
+type Date struct {
+	null    bool
+	unknown bool
+	year    int
+	month   time.Month
+	day     int
+}
+
+// Day returns the day of the month of a Date.
+func (v Date) Day() int {
+	return v.day
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a Date type
+//   - Has the same null, unknown, and value data
+func (v Date) Equal(o attr.Value) bool {
+	otherValue, ok := o.(Date)
+
+	if !ok {
+		return false
+	}
+
+	if otherValue.null != v.null {
+		return false
+	}
+
+	if otherValue.unknown != v.unknown {
+		return false
+	}
+
+	return otherValue.year == v.year && otherValue.month == v.month && otherValue.day == v.day
+}
+
+// IsNull returns true if the Date represents a null Value.
+func (v Date) IsNull() bool {
+	return v.null
+}
+
+// IsUnknown returns true if the Date represents an unknown Value.
+func (v Date) IsUnknown() bool {
+	return v.unknown
+}
+
+// Month returns the month of a Date.
+func (v Date) Month() time.Month {
+	return v.month
+}
+
+// String returns a human readable string of the Date.
+func (v Date) String() string {
+	if v.null {
+		return attr.NullValueString
+	}
+
+	if v.unknown {
+		return attr.UnknownValueString
+	}
+
+	return `"` + v.ValueString() + `"`
+}
+
+// TimeIn returns the time.Time of midnight at the start of the Date in the
+// given location.
+func (v Date) TimeIn(loc *time.Location) time.Time {
+	return time.Date(v.year, v.month, v.day, 0, 0, 0, 0, loc)
+}
+
+// ToStringValue converts the Date to a types.String.
+func (v Date) ToStringValue(_ context.Context) (basetypes.StringValue, diag.Diagnostics) {
+	if v.null {
+		return basetypes.NewStringNull(), nil
+	}
+
+	if v.unknown {
+		return basetypes.NewStringUnknown(), nil
+	}
+
+	return basetypes.NewStringValue(v.ValueString()), nil
+}
+
+// ToTerraformValue converts the Date to a tftypes.String.
+func (v Date) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	if v.null {
+		return tftypes.NewValue(tftypes.String, nil), nil
+	}
+
+	if v.unknown {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+
+	return tftypes.NewValue(tftypes.String, v.ValueString()), nil
+}
+
+// Type returns the attr.Type of Date.
+func (v Date) Type(_ context.Context) attr.Type {
+	return DateType{}
+}
+
+// ValueString returns the YYYY-MM-DD string representation of a known Date.
+// An empty string is returned for a null or unknown Date.
+func (v Date) ValueString() string {
+	if v.null || v.unknown {
+		return ""
+	}
+
+	return fmt.Sprintf("%04d-%02d-%02d", v.year, v.month, v.day)
+}
+
+// Year returns the year of a Date.
+func (v Date) Year() int {
+	return v.year
+}
@@ -0,0 +1,595 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDateDay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		expected int
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: 0,
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: 0,
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Day()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.DateNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.Date": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			other:    types.StringValue("2006-01-02"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.DateNull(),
+			other:    timetypes.DateNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.DateNull(),
+			other:    timetypes.DateUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.DateNull(),
+			other:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.DateUnknown(),
+			other:    timetypes.DateNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.DateUnknown(),
+			other:    timetypes.DateUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.DateUnknown(),
+			other:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			other:    timetypes.DateNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			other:    timetypes.DateUnknown(),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			other:    testValueFrom(t, timetypes.DateTime, time.Date(2007, 2, 3, 0, 0, 0, 0, time.UTC)),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			other:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expected: true,
+		},
+		"value-value-equal-different-time": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			other:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateMonth(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		expected time.Month
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: 0,
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: 0,
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: time.January,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Month()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		expected string
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: "\"2006-01-02\"",
+		},
+		"value-offset-date-change": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 23, 0, 0, 0, time.UTC).In(time.FixedZone("", 7*60*60))),
+			expected: "\"2006-01-03\"",
+		},
+		"value-year-padding": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(206, 1, 2, 0, 0, 0, 0, time.UTC)),
+			expected: "\"0206-01-02\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time          time.Time
+		expected      timetypes.Date
+		expectedDiags diag.Diagnostics
+	}{
+		"location": {
+			time:     time.Date(2006, 1, 2, 23, 0, 0, 0, time.FixedZone("", -7*60*60)),
+			expected: testValue(t, timetypes.DateString, "2006-01-02"),
+		},
+		"utc": {
+			time:     time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			expected: testValue(t, timetypes.DateString, "2006-01-02"),
+		},
+		"year-0000": {
+			time:     time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: testValue(t, timetypes.DateString, "0000-01-01"),
+		},
+		"year-9999": {
+			time:     time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+			expected: testValue(t, timetypes.DateString, "9999-12-31"),
+		},
+		"year-after-9999": {
+			time:     time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: timetypes.DateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Date Conversion Error",
+					"An unexpected error occurred while converting a time to a Date. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 10000-01-01T00:00:00Z is outside the years 0000 to 9999, which RFC 3339 full-date cannot represent.",
+				),
+			},
+		},
+		"year-before-0000": {
+			time:     time.Date(-1, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: timetypes.DateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Date Conversion Error",
+					"An unexpected error occurred while converting a time to a Date. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time -0001-01-01T00:00:00Z is outside the years 0000 to 9999, which RFC 3339 full-date cannot represent.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.DateTime(testCase.time)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTimeIn(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		loc      *time.Location
+		expected time.Time
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			loc:      time.UTC,
+			expected: time.Date(0, 0, 0, 0, 0, 0, 0, time.UTC),
+		},
+		"value-offset": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			loc:      time.FixedZone("", -7*60*60),
+			expected: time.Date(2006, 1, 2, 0, 0, 0, 0, time.FixedZone("", -7*60*60)),
+		},
+		"value-utc": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			loc:      time.UTC,
+			expected: time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.TimeIn(testCase.loc)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.Date
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: types.StringValue("2006-01-02"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.Date
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.DateNull(),
+			expected: timetypes.DateType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		expected string
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: "2006-01-02",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateYear(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Date
+		expected int
+	}{
+		"null": {
+			value:    timetypes.DateNull(),
+			expected: 0,
+		},
+		"unknown": {
+			value:    timetypes.DateUnknown(),
+			expected: 0,
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: 2006,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Year()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = DateType{}
+	_ attr.Type                    = DateType{}
+	_ basetypes.StringTypable      = DateType{}
+	_ xattr.TypeWithValidate       = DateType{}
+)
+
+// DateType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are RFC 3339 full-date strings, such as
+// 2006-01-02.
+type DateType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t DateType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is DateType.
+func (t DateType) Equal(o attr.Type) bool {
+	_, ok := o.(DateType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t DateType) String() string {
+	return "timetypes.DateType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t DateType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always RFC 3339 full-date conformant.
+func (t DateType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid Date Terraform Value",
+				"An unexpected error occurred while attempting to read a date string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := DateString(str, schemaPath)
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t DateType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return DateNull(), nil
+	}
+
+	if in.IsUnknown() {
+		return DateUnknown(), nil
+	}
+
+	return DateString(in.ValueString(), path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t DateType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return DateNull(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return DateUnknown(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return DateUnknown(), err
+	}
+
+	year, month, day, err := parseFullDate(str)
+
+	if err != nil {
+		return DateUnknown(), err
+	}
+
+	return Date{
+		year:  year,
+		month: month,
+		day:   day,
+	}, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t DateType) ValueType(_ context.Context) attr.Value {
+	return Date{}
+}
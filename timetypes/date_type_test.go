@@ -0,0 +1,443 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDateTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.DateType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.DateType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.DateType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.DateType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.DateType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.DateType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.DateType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.DateType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.DateType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.DateType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.DateType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.DateType": {
+			typ:      timetypes.DateType{},
+			other:    timetypes.DateType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.DateType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.DateType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.DateType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.DateType{},
+			expected: "timetypes.DateType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.DateType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.DateType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.DateType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Date Terraform Value",
+					"An unexpected error occurred while attempting to read a date string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-date-format"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 full-date format. "+
+						"The RFC 3339 full-date string format is YYYY-MM-DD, such as 2006-01-02.\n\n"+
+						"Invalid date-fullyear at character 1, expected 4 digits:\n\n"+
+						"    not-date-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-date-mday": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-02-29"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 full-date format. "+
+						"The RFC 3339 full-date string format is YYYY-MM-DD, such as 2006-01-02.\n\n"+
+						"Invalid date-mday at character 9, expected 01-28:\n\n"+
+						"    2006-02-29\n"+
+						"            ^",
+				),
+			},
+		},
+		"string-value-invalid-date-time": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 full-date format. "+
+						"The RFC 3339 full-date string format is YYYY-MM-DD, such as 2006-01-02.\n\n"+
+						"Invalid full-date at character 11, expected end of string:\n\n"+
+						"    2006-01-02T15:04:05Z\n"+
+						"              ^",
+				),
+			},
+		},
+		"string-value-valid": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-leap-year": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2004-02-29"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.DateType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.DateType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.DateNull(),
+		},
+		"unknown": {
+			typ:         timetypes.DateType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.DateUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.DateType{},
+			stringValue: types.StringValue("not-date-format"),
+			expected:    timetypes.DateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 full-date format. "+
+						"The RFC 3339 full-date string format is YYYY-MM-DD, such as 2006-01-02.\n\n"+
+						"Invalid date-fullyear at character 1, expected 4 digits:\n\n"+
+						"    not-date-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.DateType{},
+			stringValue: types.StringValue("2006-01-02"),
+			expected:    testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.DateType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.DateUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.DateNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.DateUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-date-format"),
+			expected:       timetypes.DateUnknown(),
+			expectedError:  fmt.Errorf("parsing \"not-date-format\" as RFC 3339 full-date: invalid date-fullyear at offset 0: expected 4 digits"),
+		},
+		"string-value-valid": {
+			typ:            timetypes.DateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02"),
+			expected:       testValueFrom(t, timetypes.DateTime, time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDateTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.DateType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.DateType{},
+			expected: timetypes.Date{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
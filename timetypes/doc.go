@@ -1,5 +1,6 @@
-// package timetypes implements a terraform-plugin-framework attr.Type and
-// attr.Value for [RFC 3339] timestamp strings.
+// package timetypes implements terraform-plugin-framework attr.Type and
+// attr.Value types for time related strings, such as [RFC 3339] timestamps
+// and dates.
 //
 // [RFC 3339]: https://tools.ietf.org/html/rfc3339
 package timetypes
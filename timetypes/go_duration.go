@@ -0,0 +1,128 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = GoDuration{}
+	_ basetypes.StringValuable                   = GoDuration{}
+	_ basetypes.StringValuableWithSemanticEquals = GoDuration{}
+)
+
+// GoDurationDuration returns a known GoDuration with the given duration.
+// The string representation is the time.Duration String method output, such
+// as 1h30m0s.
+func GoDurationDuration(d time.Duration) GoDuration {
+	return GoDuration{
+		stringValue: stringValue{
+			valueString: d.String(),
+		},
+		value: d,
+	}
+}
+
+// GoDurationNull returns a null GoDuration.
+func GoDurationNull() GoDuration {
+	return GoDuration{
+		stringValue: stringValue{
+			null: true,
+		},
+	}
+}
+
+// GoDurationString returns a known GoDuration or any errors while
+// attempting to parse the string as Go duration format.
+func GoDurationString(s string, schemaPath path.Path) (GoDuration, diag.Diagnostics) {
+	d, err := time.ParseDuration(s)
+
+	if err != nil {
+		return GoDuration{
+				stringValue: stringValue{
+					unknown: true,
+				},
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid Go Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Go duration format. "+
+						"The Go duration string format is a sequence of decimal numbers, each with optional fraction and a unit suffix, such as 30s, 1.5h, or 1h30m. "+
+						"Valid units are ns, us (or µs), ms, s, m, and h.\n\n"+
+						"Error: "+err.Error(),
+				),
+			}
+	}
+
+	return GoDuration{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		value: d,
+	}, nil
+}
+
+// GoDurationUnknown returns an unknown GoDuration.
+func GoDurationUnknown() GoDuration {
+	return GoDuration{
+		stringValue: stringValue{
+			unknown: true,
+		},
+	}
+}
+
+// GoDuration implements the attr.Value interface for usage in logic.
+type GoDuration struct {
+	stringValue
+
+	value time.Duration
+}
+
+// Duration returns the time.Duration of a GoDuration.
+func (v GoDuration) Duration() time.Duration {
+	return v.value
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a GoDuration type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented durations instead.
+func (v GoDuration) Equal(o attr.Value) bool {
+	otherValue, ok := o.(GoDuration)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// StringSemanticEquals returns true if the given GoDuration represents the
+// same duration, regardless of the string representation, such as 90m and
+// 1h30m. The framework calls this method to keep the prior value and
+// prevent unexpected differences.
+func (v GoDuration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(GoDuration)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.value == newValue.value, diags
+}
+
+// Type returns the attr.Type of GoDuration.
+func (v GoDuration) Type(_ context.Context) attr.Type {
+	return GoDurationType{}
+}
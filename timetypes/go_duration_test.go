@@ -0,0 +1,490 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGoDurationDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.GoDuration
+		expected time.Duration
+	}{
+		"null": {
+			value:    timetypes.GoDurationNull(),
+			expected: 0,
+		},
+		"unknown": {
+			value:    timetypes.GoDurationUnknown(),
+			expected: 0,
+		},
+		"value-duration": {
+			value:    timetypes.GoDurationDuration(90 * time.Minute),
+			expected: 90 * time.Minute,
+		},
+		"value-string-fractional": {
+			value:    testValue(t, timetypes.GoDurationString, "1.5h"),
+			expected: 90 * time.Minute,
+		},
+		"value-string-negative": {
+			value:    testValue(t, timetypes.GoDurationString, "-30s"),
+			expected: -30 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Duration()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.GoDuration
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.GoDurationNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.GoDuration": {
+			value:    testValue(t, timetypes.GoDurationString, "30s"),
+			other:    types.StringValue("30s"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.GoDurationNull(),
+			other:    timetypes.GoDurationNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.GoDurationNull(),
+			other:    timetypes.GoDurationUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.GoDurationNull(),
+			other:    testValue(t, timetypes.GoDurationString, "30s"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.GoDurationUnknown(),
+			other:    timetypes.GoDurationNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.GoDurationUnknown(),
+			other:    timetypes.GoDurationUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.GoDurationUnknown(),
+			other:    testValue(t, timetypes.GoDurationString, "30s"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.GoDurationString, "30s"),
+			other:    timetypes.GoDurationNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.GoDurationString, "30s"),
+			other:    timetypes.GoDurationUnknown(),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.GoDurationString, "30s"),
+			other:    testValue(t, timetypes.GoDurationString, "31s"),
+			expected: false,
+		},
+		"value-value-different-string-same-duration": {
+			value:    testValue(t, timetypes.GoDurationString, "90m"),
+			other:    testValue(t, timetypes.GoDurationString, "1h30m"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.GoDurationString, "1h30m0s"),
+			other:    timetypes.GoDurationDuration(90 * time.Minute),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.GoDuration
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.GoDurationNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.GoDurationUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.GoDurationString, "30s"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.GoDuration
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.GoDurationNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.GoDurationUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.GoDurationString, "30s"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.GoDuration
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.GoDuration": {
+			value:    testValue(t, timetypes.GoDurationString, "30s"),
+			newValue: types.StringValue("30s"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.GoDuration\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.GoDurationString, "90m"),
+			newValue: testValue(t, timetypes.GoDurationString, "1h31m"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.GoDurationString, "30s"),
+			newValue: testValue(t, timetypes.GoDurationString, "30s"),
+			expected: true,
+		},
+		"value-value-fractional": {
+			value:    testValue(t, timetypes.GoDurationString, "1.5h"),
+			newValue: testValue(t, timetypes.GoDurationString, "1h30m"),
+			expected: true,
+		},
+		"value-value-units": {
+			value:    testValue(t, timetypes.GoDurationString, "90m"),
+			newValue: testValue(t, timetypes.GoDurationString, "1h30m0s"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.GoDuration
+		expected string
+	}{
+		"null": {
+			value:    timetypes.GoDurationNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.GoDurationUnknown(),
+			expected: "<unknown>",
+		},
+		"value-duration": {
+			value:    timetypes.GoDurationDuration(90 * time.Minute),
+			expected: "\"1h30m0s\"",
+		},
+		"value-string": {
+			value:    testValue(t, timetypes.GoDurationString, "90m"),
+			expected: "\"90m\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.GoDuration
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.GoDurationNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.GoDurationUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.GoDurationString, "90m"),
+			expected: types.StringValue("90m"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.GoDuration
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.GoDurationNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.GoDurationUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value-duration": {
+			value:    timetypes.GoDurationDuration(30 * time.Second),
+			expected: tftypes.NewValue(tftypes.String, "30s"),
+		},
+		"value-string": {
+			value:    testValue(t, timetypes.GoDurationString, "90m"),
+			expected: tftypes.NewValue(tftypes.String, "90m"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.GoDuration
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.GoDurationNull(),
+			expected: timetypes.GoDurationType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.GoDuration
+		expected string
+	}{
+		"null": {
+			value:    timetypes.GoDurationNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.GoDurationUnknown(),
+			expected: "",
+		},
+		"value-duration": {
+			value:    timetypes.GoDurationDuration(1500 * time.Millisecond),
+			expected: "1.5s",
+		},
+		"value-string": {
+			value:    testValue(t, timetypes.GoDurationString, "1500ms"),
+			expected: "1500ms",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
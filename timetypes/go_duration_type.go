@@ -0,0 +1,127 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = GoDurationType{}
+	_ attr.Type                    = GoDurationType{}
+	_ basetypes.StringTypable      = GoDurationType{}
+	_ xattr.TypeWithValidate       = GoDurationType{}
+)
+
+// GoDurationType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are Go duration strings, as accepted by
+// time.ParseDuration, such as 30s or 1h30m.
+type GoDurationType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t GoDurationType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is GoDurationType.
+func (t GoDurationType) Equal(o attr.Type) bool {
+	_, ok := o.(GoDurationType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t GoDurationType) String() string {
+	return "timetypes.GoDurationType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t GoDurationType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always Go duration conformant.
+func (t GoDurationType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid Go Duration Terraform Value",
+				"An unexpected error occurred while attempting to read a Go duration string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := GoDurationString(str, schemaPath)
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t GoDurationType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return GoDurationNull(), nil
+	}
+
+	if in.IsUnknown() {
+		return GoDurationUnknown(), nil
+	}
+
+	return GoDurationString(in.ValueString(), path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t GoDurationType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return GoDurationNull(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return GoDurationUnknown(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return GoDurationUnknown(), err
+	}
+
+	d, err := time.ParseDuration(str)
+
+	if err != nil {
+		return GoDurationUnknown(), err
+	}
+
+	return GoDuration{
+		stringValue: stringValue{
+			valueString: str,
+		},
+		value: d,
+	}, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t GoDurationType) ValueType(_ context.Context) attr.Value {
+	return GoDuration{}
+}
@@ -0,0 +1,454 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGoDurationTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.GoDurationType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.GoDurationType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.GoDurationType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.GoDurationType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.GoDurationType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.GoDurationType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.GoDurationType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.GoDurationType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.GoDurationType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.GoDurationType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.GoDurationType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.GoDurationType": {
+			typ:      timetypes.GoDurationType{},
+			other:    timetypes.GoDurationType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.GoDurationType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.GoDurationType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.GoDurationType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.GoDurationType{},
+			expected: "timetypes.GoDurationType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.GoDurationType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.GoDurationType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.GoDurationType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Go Duration Terraform Value",
+					"An unexpected error occurred while attempting to read a Go duration string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-duration-format"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Go Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Go duration format. "+
+						"The Go duration string format is a sequence of decimal numbers, each with optional fraction and a unit suffix, such as 30s, 1.5h, or 1h30m. "+
+						"Valid units are ns, us (or µs), ms, s, m, and h.\n\n"+
+						"Error: time: invalid duration \"not-duration-format\"",
+				),
+			},
+		},
+		"string-value-invalid-missing-unit": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "30"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Go Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Go duration format. "+
+						"The Go duration string format is a sequence of decimal numbers, each with optional fraction and a unit suffix, such as 30s, 1.5h, or 1h30m. "+
+						"Valid units are ns, us (or µs), ms, s, m, and h.\n\n"+
+						"Error: time: missing unit in duration \"30\"",
+				),
+			},
+		},
+		"string-value-invalid-unknown-unit": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1d"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Go Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Go duration format. "+
+						"The Go duration string format is a sequence of decimal numbers, each with optional fraction and a unit suffix, such as 30s, 1.5h, or 1h30m. "+
+						"Valid units are ns, us (or µs), ms, s, m, and h.\n\n"+
+						"Error: time: unknown unit \"d\" in duration \"1d\"",
+				),
+			},
+		},
+		"string-value-valid-fractional": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1.5h"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-multiple-units": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1h30m"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-negative": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-30s"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-zero": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "0"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.GoDurationType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.GoDurationType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.GoDurationNull(),
+		},
+		"unknown": {
+			typ:         timetypes.GoDurationType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.GoDurationUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.GoDurationType{},
+			stringValue: types.StringValue("not-duration-format"),
+			expected:    timetypes.GoDurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Go Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Go duration format. "+
+						"The Go duration string format is a sequence of decimal numbers, each with optional fraction and a unit suffix, such as 30s, 1.5h, or 1h30m. "+
+						"Valid units are ns, us (or µs), ms, s, m, and h.\n\n"+
+						"Error: time: invalid duration \"not-duration-format\"",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.GoDurationType{},
+			stringValue: types.StringValue("90m"),
+			expected:    testValue(t, timetypes.GoDurationString, "90m"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.GoDurationType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.GoDurationUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.GoDurationNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.GoDurationUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-duration-format"),
+			expected:       timetypes.GoDurationUnknown(),
+			expectedError:  fmt.Errorf("time: invalid duration \"not-duration-format\""),
+		},
+		"string-value-valid": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1h30m0s"),
+			expected:       timetypes.GoDurationDuration(90 * time.Minute),
+		},
+		"string-value-valid-preserved": {
+			typ:            timetypes.GoDurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "90m"),
+			expected:       testValue(t, timetypes.GoDurationString, "90m"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.GoDurationType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.GoDurationType{},
+			expected: timetypes.GoDuration{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
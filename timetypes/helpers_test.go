@@ -0,0 +1,60 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// testValue returns the value created by constructor from a string which is
+// known to be valid, failing the test on any error diagnostics.
+func testValue[T any](t *testing.T, constructor func(string, path.Path) (T, diag.Diagnostics), s string) T {
+	t.Helper()
+
+	value, diags := constructor(s, path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics creating test value from %q: %v", s, diags)
+	}
+
+	return value
+}
+
+// testValueFrom returns the value created by constructor from an input which
+// is known to be valid, failing the test on any error diagnostics.
+func testValueFrom[I, T any](t *testing.T, constructor func(I) (T, diag.Diagnostics), input I) T {
+	t.Helper()
+
+	value, diags := constructor(input)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics creating test value from %v: %v", input, diags)
+	}
+
+	return value
+}
+
+// testValueFromString returns the value created by the ValueFromString method
+// of typ from a string which is known to be valid, failing the test on any
+// error diagnostics.
+func testValueFromString[T basetypes.StringValuable](t *testing.T, typ basetypes.StringTypable, s string) T {
+	t.Helper()
+
+	valuable, diags := typ.ValueFromString(context.Background(), types.StringValue(s))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics creating test value from %q: %v", s, diags)
+	}
+
+	value, ok := valuable.(T)
+
+	if !ok {
+		t.Fatalf("unexpected test value type %T created from %q", valuable, s)
+	}
+
+	return value
+}
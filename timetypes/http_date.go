@@ -0,0 +1,130 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = HTTPDate{}
+	_ basetypes.StringValuable                   = HTTPDate{}
+	_ basetypes.StringValuableWithSemanticEquals = HTTPDate{}
+)
+
+// HTTPDateNull returns a null HTTPDate.
+func HTTPDateNull() HTTPDate {
+	return HTTPDate{
+		timestamp{
+			stringValue: stringValue{
+				null: true,
+			},
+		},
+	}
+}
+
+// HTTPDateString returns a known HTTPDate or any errors while attempting to
+// parse the string as IMF-fixdate format. Use HTTPDateType with
+// AllowObsoleteFormats to also accept the obsolete formats.
+func HTTPDateString(s string, schemaPath path.Path) (HTTPDate, diag.Diagnostics) {
+	value, diags := timestampString(HTTPDateType{}, s, schemaPath)
+
+	return HTTPDate{value}, diags
+}
+
+// HTTPDateTime returns a known HTTPDate with the given time converted to UTC
+// and formatted as IMF-fixdate. Fractional seconds are truncated.
+func HTTPDateTime(t time.Time) HTTPDate {
+	return HTTPDateType{}.ValueFromTime(t)
+}
+
+// HTTPDateUnknown returns an unknown HTTPDate.
+func HTTPDateUnknown() HTTPDate {
+	return HTTPDate{
+		timestamp{
+			stringValue: stringValue{
+				unknown: true,
+			},
+		},
+	}
+}
+
+// HTTPDate implements the attr.Value interface for usage in logic. It
+// represents an [RFC 9110] HTTP-date, such as the value of the Expires or
+// Last-Modified headers.
+//
+// [RFC 9110]: https://www.rfc-editor.org/rfc/rfc9110#section-5.6.7
+type HTTPDate struct {
+	timestamp
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is an HTTPDate type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented instants instead.
+func (v HTTPDate) Equal(o attr.Value) bool {
+	otherValue, ok := o.(HTTPDate)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// IMFFixdate returns the IMF-fixdate string representation of a known
+// HTTPDate, such as Sun, 06 Nov 1994 08:49:37 GMT, regardless of the format
+// of the original string. Use this method when sending the value in an HTTP
+// header. An empty string is returned for a null or unknown HTTPDate.
+func (v HTTPDate) IMFFixdate() string {
+	if v.null || v.unknown {
+		return ""
+	}
+
+	return v.value.UTC().Format(httpDateLayout)
+}
+
+// StringSemanticEquals returns true if the given HTTPDate represents the
+// same instant in time, regardless of the format of the string
+// representation, such as the IMF-fixdate and obsolete RFC 850 formats. The
+// framework calls this method to keep the prior value and prevent unexpected
+// differences.
+func (v HTTPDate) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(HTTPDate)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.value.Equal(newValue.value), diags
+}
+
+// ToRFC3339 converts the HTTPDate to an RFC3339 in UTC, such as
+// 1994-11-06T08:49:37Z, or an error diagnostic if the year is after 9999. A
+// null or unknown HTTPDate returns a null or unknown RFC3339.
+func (v HTTPDate) ToRFC3339() (RFC3339, diag.Diagnostics) {
+	if v.null {
+		return RFC3339Null(), nil
+	}
+
+	if v.unknown {
+		return RFC3339Unknown(), nil
+	}
+
+	return RFC3339Time(v.value)
+}
+
+// Type returns the attr.Type of HTTPDate.
+func (v HTTPDate) Type(_ context.Context) attr.Type {
+	return HTTPDateType{}
+}
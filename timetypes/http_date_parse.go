@@ -0,0 +1,398 @@
+package timetypes
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// httpDateLayout is the time package layout of the IMF-fixdate format.
+const httpDateLayout = "Mon, 02 Jan 2006 15:04:05 GMT"
+
+// httpDateDayNames are the day-name values, in time.Weekday order.
+var httpDateDayNames = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// httpDateDayNamesLong are the day-name-l values, in time.Weekday order.
+var httpDateDayNamesLong = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// httpDateMonths are the month values, in time.Month order.
+var httpDateMonths = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+
+// ParseHTTPDate parses a string using the [RFC 9110 section 5.6.7]
+// IMF-fixdate grammar, such as Sun, 06 Nov 1994 08:49:37 GMT. The day-name
+// must match the date and names are case-sensitive. Leap seconds (second 60)
+// are accepted when they occur at the end of a day which is the last day of
+// a month and are returned as the following second. The returned time is in
+// UTC. The obsolete RFC 850 and asctime formats are not accepted, use
+// HTTPDateType with AllowObsoleteFormats to accept them.
+//
+// Any returned error is a *ParseError.
+//
+// [RFC 9110 section 5.6.7]: https://www.rfc-editor.org/rfc/rfc9110#section-5.6.7
+func ParseHTTPDate(s string) (time.Time, error) {
+	return parseHTTPDate(s, false, time.Now())
+}
+
+// parseHTTPDate parses a string using the RFC 9110 section 5.6.7 HTTP-date
+// grammar. If obsolete is false, only IMF-fixdate is accepted. The two digit
+// year of the RFC 850 format is interpreted relative to now, as required by
+// RFC 9110. Any returned error is a *ParseError.
+func parseHTTPDate(s string, obsolete bool, now time.Time) (time.Time, error) {
+	p := &httpDateParser{
+		rfc3339Parser: rfc3339Parser{
+			format: "HTTP-date",
+			input:  s,
+		},
+		now: now,
+	}
+
+	if !obsolete {
+		return p.imfFixdate()
+	}
+
+	// Each format is distinguished by the character following the short
+	// day-name: "," for IMF-fixdate, SP for asctime-date, and the remaining
+	// letters of day-name-l for rfc850-date.
+	switch {
+	case len(s) > 3 && s[3] == ' ':
+		return p.asctimeDate()
+	case len(s) > 3 && s[3] != ',':
+		return p.rfc850Date()
+	default:
+		return p.imfFixdate()
+	}
+}
+
+// httpDateParser is a parser for the HTTP-date formats. It reuses the
+// character and digit handling of rfc3339Parser and the component names in
+// errors follow the RFC 9110 grammar.
+type httpDateParser struct {
+	rfc3339Parser
+
+	// now is the reference time for interpreting two digit years.
+	now time.Time
+
+	// dayNameOffset, dayOffset, and secondOffset are the offsets of the
+	// components which are verified after the whole string is parsed.
+	dayNameOffset int
+	dayOffset     int
+	secondOffset  int
+}
+
+// imfFixdate parses:
+// IMF-fixdate = day-name "," SP date1 SP time-of-day SP GMT
+func (p *httpDateParser) imfFixdate() (time.Time, error) {
+	p.dayNameOffset = p.offset
+	weekday, err := p.name("day-name", httpDateDayNames)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("IMF-fixdate", ",", ','); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("IMF-fixdate", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	// date1 = day SP month SP year
+	p.dayOffset = p.offset
+	day, err := p.digits("day", 2, 1, 31)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("date1", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	month, err := p.name("month", httpDateMonths)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("date1", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	year, err := p.digits("year", 4, 0, 9999)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("IMF-fixdate", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	p.secondOffset = p.offset + 6
+	hour, minute, second, err := p.timeOfDay()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("IMF-fixdate", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.gmt(); err != nil {
+		return time.Time{}, err
+	}
+
+	return p.date(year, month+1, day, hour, minute, second, weekday, "day-name", httpDateDayNames)
+}
+
+// rfc850Date parses:
+// rfc850-date = day-name-l "," SP date2 SP time-of-day SP GMT
+func (p *httpDateParser) rfc850Date() (time.Time, error) {
+	p.dayNameOffset = p.offset
+	weekday, err := p.name("day-name-l", httpDateDayNamesLong)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("rfc850-date", ",", ','); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("rfc850-date", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	// date2 = day "-" month "-" 2DIGIT
+	p.dayOffset = p.offset
+	day, err := p.digits("day", 2, 1, 31)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("date2", "-", '-'); err != nil {
+		return time.Time{}, err
+	}
+
+	month, err := p.name("month", httpDateMonths)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("date2", "-", '-'); err != nil {
+		return time.Time{}, err
+	}
+
+	year, err := p.digits("date2", 2, 0, 99)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// RFC 9110 requires interpreting a two digit year which appears to be
+	// more than 50 years in the future as the most recent past year with
+	// the same last two digits.
+	year += p.now.Year() / 100 * 100
+
+	if year > p.now.Year()+50 {
+		year -= 100
+	}
+
+	if err := p.char("rfc850-date", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	p.secondOffset = p.offset + 6
+	hour, minute, second, err := p.timeOfDay()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("rfc850-date", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.gmt(); err != nil {
+		return time.Time{}, err
+	}
+
+	return p.date(year, month+1, day, hour, minute, second, weekday, "day-name-l", httpDateDayNamesLong)
+}
+
+// asctimeDate parses:
+// asctime-date = day-name SP date3 SP time-of-day SP year
+func (p *httpDateParser) asctimeDate() (time.Time, error) {
+	p.dayNameOffset = p.offset
+	weekday, err := p.name("day-name", httpDateDayNames)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("asctime-date", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	// date3 = month SP ( 2DIGIT / ( SP DIGIT ) )
+	month, err := p.name("month", httpDateMonths)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("date3", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	p.dayOffset = p.offset
+
+	var day int
+
+	if p.offset < len(p.input) && p.input[p.offset] == ' ' {
+		p.offset++
+		day, err = p.digits("day", 1, 1, 9)
+	} else {
+		day, err = p.digits("day", 2, 1, 31)
+	}
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("asctime-date", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	p.secondOffset = p.offset + 6
+	hour, minute, second, err := p.timeOfDay()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("asctime-date", " ", ' '); err != nil {
+		return time.Time{}, err
+	}
+
+	year, err := p.digits("year", 4, 0, 9999)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if p.offset != len(p.input) {
+		return time.Time{}, p.errorf("asctime-date", "end of string")
+	}
+
+	return p.date(year, month+1, day, hour, minute, second, weekday, "day-name", httpDateDayNames)
+}
+
+// timeOfDay parses: time-of-day = hour ":" minute ":" second
+func (p *httpDateParser) timeOfDay() (int, int, int, error) {
+	hour, err := p.digits("hour", 2, 0, 23)
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if err := p.char("time-of-day", ":", ':'); err != nil {
+		return 0, 0, 0, err
+	}
+
+	minute, err := p.digits("minute", 2, 0, 59)
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if err := p.char("time-of-day", ":", ':'); err != nil {
+		return 0, 0, 0, err
+	}
+
+	second, err := p.digits("second", 2, 0, 60)
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return hour, minute, second, nil
+}
+
+// gmt parses GMT = %s"GMT" followed by the end of the string.
+func (p *httpDateParser) gmt() error {
+	if len(p.input)-p.offset < 3 || p.input[p.offset:p.offset+3] != "GMT" {
+		return p.errorf("GMT", `"GMT"`)
+	}
+
+	p.offset += 3
+
+	if p.offset != len(p.input) {
+		return p.errorf("HTTP-date", "end of string")
+	}
+
+	return nil
+}
+
+// name consumes one of the given case-sensitive names and returns its index.
+// Longer names are preferred, so a name which is a prefix of another does not
+// match early.
+func (p *httpDateParser) name(component string, names []string) (int, error) {
+	index := -1
+
+	for i, name := range names {
+		if len(p.input)-p.offset < len(name) || p.input[p.offset:p.offset+len(name)] != name {
+			continue
+		}
+
+		if index == -1 || len(name) > len(names[index]) {
+			index = i
+		}
+	}
+
+	if index == -1 {
+		expected := make([]string, 0, len(names))
+
+		for _, name := range names {
+			expected = append(expected, strconv.Quote(name))
+		}
+
+		return 0, p.errorf(component, joinExpected(expected))
+	}
+
+	p.offset += len(names[index])
+
+	return index, nil
+}
+
+// date verifies the parsed day of month, leap second, and day name and
+// returns the time in UTC.
+func (p *httpDateParser) date(year int, month int, day int, hour int, minute int, second int, weekday int, dayNameComponent string, dayNames []string) (time.Time, error) {
+	if lastDay := daysIn(year, month); day > lastDay {
+		p.offset = p.dayOffset
+
+		return time.Time{}, p.errorf("day", fmt.Sprintf("01-%02d", lastDay))
+	}
+
+	if second == 60 && (hour != 23 || minute != 59 || day != daysIn(year, month)) {
+		p.offset = p.secondOffset
+
+		return time.Time{}, p.errorf("second", "00-59, 60 is only valid for a leap second at the end of a month")
+	}
+
+	t := time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC)
+
+	// Use the date without any leap second, which would otherwise move to
+	// the following day.
+	if actual := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Weekday(); int(actual) != weekday {
+		p.offset = p.dayNameOffset
+
+		return time.Time{}, p.errorf(dayNameComponent, fmt.Sprintf("%q, the day of the week of %04d-%02d-%02d", dayNames[actual], year, month, day))
+	}
+
+	return t, nil
+}
@@ -0,0 +1,206 @@
+package timetypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseHTTPDate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      time.Time
+		expectedError *timetypes.ParseError
+	}{
+		// RFC 9110 section 5.6.7 example.
+		"rfc9110-example": {
+			input:    "Sun, 06 Nov 1994 08:49:37 GMT",
+			expected: time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC),
+		},
+
+		// Additional valid strings.
+		"valid-feb-29-leap-year": {
+			input:    "Sun, 29 Feb 2004 00:00:00 GMT",
+			expected: time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"valid-leap-second": {
+			input:    "Mon, 31 Dec 1990 23:59:60 GMT",
+			expected: time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"valid-year-9999": {
+			input:    "Fri, 31 Dec 9999 23:59:59 GMT",
+			expected: time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+
+		// Invalid strings.
+		"invalid-empty": {
+			input: "",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "",
+				Offset:    0,
+				Component: "day-name",
+				Expected:  `"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", or "Sat"`,
+			},
+		},
+		"invalid-asctime": {
+			input: "Sun Nov  6 08:49:37 1994",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun Nov  6 08:49:37 1994",
+				Offset:    3,
+				Component: "IMF-fixdate",
+				Expected:  `","`,
+			},
+		},
+		"invalid-day-feb-29-non-leap-year": {
+			input: "Tue, 29 Feb 2005 00:00:00 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Tue, 29 Feb 2005 00:00:00 GMT",
+				Offset:    5,
+				Component: "day",
+				Expected:  "01-28",
+			},
+		},
+		"invalid-day-name-lowercase": {
+			input: "sun, 06 Nov 1994 08:49:37 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "sun, 06 Nov 1994 08:49:37 GMT",
+				Offset:    0,
+				Component: "day-name",
+				Expected:  `"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", or "Sat"`,
+			},
+		},
+		"invalid-day-name-mismatch": {
+			input: "Mon, 06 Nov 1994 08:49:37 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Mon, 06 Nov 1994 08:49:37 GMT",
+				Offset:    0,
+				Component: "day-name",
+				Expected:  `"Sun", the day of the week of 1994-11-06`,
+			},
+		},
+		"invalid-day-single-digit": {
+			input: "Sun, 6 Nov 1994 08:49:37 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun, 6 Nov 1994 08:49:37 GMT",
+				Offset:    6,
+				Component: "day",
+				Expected:  "2 digits",
+			},
+		},
+		"invalid-gmt-missing": {
+			input: "Sun, 06 Nov 1994 08:49:37",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun, 06 Nov 1994 08:49:37",
+				Offset:    25,
+				Component: "IMF-fixdate",
+				Expected:  `" "`,
+			},
+		},
+		"invalid-gmt-utc": {
+			input: "Sun, 06 Nov 1994 08:49:37 UTC",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun, 06 Nov 1994 08:49:37 UTC",
+				Offset:    26,
+				Component: "GMT",
+				Expected:  `"GMT"`,
+			},
+		},
+		"invalid-hour": {
+			input: "Sun, 06 Nov 1994 24:49:37 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun, 06 Nov 1994 24:49:37 GMT",
+				Offset:    17,
+				Component: "hour",
+				Expected:  "00-23",
+			},
+		},
+		"invalid-month": {
+			input: "Sun, 06 NOV 1994 08:49:37 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun, 06 NOV 1994 08:49:37 GMT",
+				Offset:    8,
+				Component: "month",
+				Expected:  `"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", or "Dec"`,
+			},
+		},
+		"invalid-rfc850": {
+			input: "Sunday, 06-Nov-94 08:49:37 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sunday, 06-Nov-94 08:49:37 GMT",
+				Offset:    3,
+				Component: "IMF-fixdate",
+				Expected:  `","`,
+			},
+		},
+		"invalid-second-leap-second-not-end-of-month": {
+			input: "Sun, 06 Nov 1994 23:59:60 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun, 06 Nov 1994 23:59:60 GMT",
+				Offset:    23,
+				Component: "second",
+				Expected:  "00-59, 60 is only valid for a leap second at the end of a month",
+			},
+		},
+		"invalid-trailing-characters": {
+			input: "Sun, 06 Nov 1994 08:49:37 GMT ",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun, 06 Nov 1994 08:49:37 GMT ",
+				Offset:    29,
+				Component: "HTTP-date",
+				Expected:  "end of string",
+			},
+		},
+		"invalid-year-two-digits": {
+			input: "Sun, 06 Nov 94 08:49:37 GMT",
+			expectedError: &timetypes.ParseError{
+				Format:    "HTTP-date",
+				Input:     "Sun, 06 Nov 94 08:49:37 GMT",
+				Offset:    14,
+				Component: "year",
+				Expected:  "4 digits",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := timetypes.ParseHTTPDate(testCase.input)
+
+			var parseErr *timetypes.ParseError
+
+			if err != nil && !errors.As(err, &parseErr) {
+				t.Fatalf("expected *timetypes.ParseError, got: %T", err)
+			}
+
+			if diff := cmp.Diff(parseErr, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,552 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestHTTPDateEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		other    attr.Value
+		expected bool
+	}{
+		"not-timetypes.HTTPDate": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			other:    types.StringValue("Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.HTTPDateNull(),
+			other:    timetypes.HTTPDateNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.HTTPDateNull(),
+			other:    timetypes.HTTPDateUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.HTTPDateNull(),
+			other:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			other:    timetypes.HTTPDateUnknown(),
+			expected: true,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			other:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:06 GMT"),
+			expected: false,
+		},
+		"value-value-different-format": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			other:    testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Monday, 02-Jan-06 15:04:05 GMT"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			other:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateIMFFixdate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		expected string
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: "Mon, 02 Jan 2006 15:04:05 GMT",
+		},
+		"value-asctime": {
+			value:    testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Mon Jan  2 15:04:05 2006"),
+			expected: "Mon, 02 Jan 2006 15:04:05 GMT",
+		},
+		"value-rfc850": {
+			value:    testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Monday, 02-Jan-06 15:04:05 GMT"),
+			expected: "Mon, 02 Jan 2006 15:04:05 GMT",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IMFFixdate()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.HTTPDate
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.HTTPDate": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			newValue: types.StringValue("Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.HTTPDate\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			newValue: testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:06 GMT"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			newValue: testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: true,
+		},
+		"value-value-asctime": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			newValue: testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Mon Jan  2 15:04:05 2006"),
+			expected: true,
+		},
+		"value-value-rfc850": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			newValue: testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Monday, 02-Jan-06 15:04:05 GMT"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		expected string
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: "\"Mon, 02 Jan 2006 15:04:05 GMT\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		expected time.Time
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: time.Time{},
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: time.Time{},
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"value-asctime": {
+			value:    testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Mon Jan  2 15:04:05 2006"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"value-rfc850-reference-time-future-year": {
+			value: testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{
+				AllowObsoleteFormats: true,
+				ReferenceTime:        time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			}, "Friday, 01-Jan-77 00:00:00 GMT"),
+			expected: time.Date(2077, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"value-rfc850-reference-time-past-year": {
+			value: testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{
+				AllowObsoleteFormats: true,
+				ReferenceTime:        time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			}, "Saturday, 01-Jan-77 00:00:00 GMT"),
+			expected: time.Date(1977, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Time()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestHTTPDateToRFC3339(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.HTTPDate
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+		},
+		"value-rfc850": {
+			value:    testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Monday, 02-Jan-06 15:04:05 GMT"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToRFC3339()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.HTTPDate
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: types.StringValue("Mon, 02 Jan 2006 15:04:05 GMT"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		expected tftypes.Value
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: tftypes.NewValue(tftypes.String, "Mon, 02 Jan 2006 15:04:05 GMT"),
+		},
+		"value-asctime": {
+			value:    testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Mon Jan  2 15:04:05 2006"),
+			expected: tftypes.NewValue(tftypes.String, "Mon Jan  2 15:04:05 2006"),
+		},
+		"value-rfc850": {
+			value:    testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Monday, 02-Jan-06 15:04:05 GMT"),
+			expected: tftypes.NewValue(tftypes.String, "Monday, 02-Jan-06 15:04:05 GMT"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		expected attr.Type
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: timetypes.HTTPDateType{},
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: timetypes.HTTPDateType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestHTTPDateValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.HTTPDate
+		expected string
+	}{
+		"null": {
+			value:    timetypes.HTTPDateNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.HTTPDateUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.HTTPDateString, "Mon, 02 Jan 2006 15:04:05 GMT"),
+			expected: "Mon, 02 Jan 2006 15:04:05 GMT",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = HTTPDateType{}
+	_ attr.Type                    = HTTPDateType{}
+	_ basetypes.StringTypable      = HTTPDateType{}
+	_ xattr.TypeWithValidate       = HTTPDateType{}
+
+	_ timestampFormatWithSuggestion = HTTPDateType{}
+)
+
+// HTTPDateType implements the attr.Type interface for usage in schema
+// definitions and data models.
+//
+// The zero value only accepts the IMF-fixdate format, such as
+// Sun, 06 Nov 1994 08:49:37 GMT. Values created from time.Time are always
+// formatted as IMF-fixdate. Type options only affect parsing, so types with
+// differing options are still considered equal.
+type HTTPDateType struct {
+	// AllowObsoleteFormats enables accepting the obsolete RFC 850 format,
+	// such as Sunday, 06-Nov-94 08:49:37 GMT, and the ANSI C asctime()
+	// format, such as Sun Nov  6 08:49:37 1994.
+	//
+	// Accepted strings in the obsolete formats are not converted to
+	// IMF-fixdate, since Terraform requires the same string it sent, so the
+	// HTTPDate ToTerraformValue and ValueString methods return the original
+	// string. Use the HTTPDate IMFFixdate method for the IMF-fixdate string
+	// representation, such as when sending the value in an HTTP header.
+	AllowObsoleteFormats bool
+
+	// ReferenceTime is the time which the two digit years of the obsolete
+	// RFC 850 format are interpreted relative to. As required by RFC 9110, a
+	// year which appears to be more than 50 years after the reference time is
+	// interpreted as the most recent past year with the same last two
+	// digits. Defaults to the current time.
+	ReferenceTime time.Time
+}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t HTTPDateType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is HTTPDateType.
+func (t HTTPDateType) Equal(o attr.Type) bool {
+	_, ok := o.(HTTPDateType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t HTTPDateType) String() string {
+	return "timetypes.HTTPDateType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t HTTPDateType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always HTTP-date conformant.
+func (t HTTPDateType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return timestampValidate(t, terraformValue, schemaPath)
+}
+
+// ValueFromString converts the types.String into a value.
+func (t HTTPDateType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value, diags := timestampValueFromString(t, in)
+
+	return HTTPDate{value}, diags
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t HTTPDateType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	value, err := timestampValueFromTerraform(t, terraformValue)
+
+	return HTTPDate{value}, err
+}
+
+// ValueFromTime returns a known HTTPDate with the given time converted to
+// UTC and formatted as IMF-fixdate. Fractional seconds are truncated.
+func (t HTTPDateType) ValueFromTime(value time.Time) HTTPDate {
+	return HTTPDate{timestampTime(t, value)}
+}
+
+// ValueType returns the associated attr.Value.
+func (t HTTPDateType) ValueType(_ context.Context) attr.Value {
+	return HTTPDate{}
+}
+
+// describe returns the HTTP-date format description for diagnostics.
+func (t HTTPDateType) describe() string {
+	description := "The HTTP-date string format is IMF-fixdate, such as Sun, 06 Nov 1994 08:49:37 GMT."
+
+	if t.AllowObsoleteFormats {
+		description += " The obsolete RFC 850 format, such as Sunday, 06-Nov-94 08:49:37 GMT, " +
+			"and asctime format, such as Sun Nov  6 08:49:37 1994, are also accepted."
+	}
+
+	return description
+}
+
+// format returns the time in UTC, truncated to seconds, and its IMF-fixdate
+// string representation.
+func (t HTTPDateType) format(value time.Time) (time.Time, string) {
+	value = value.UTC().Truncate(time.Second)
+
+	return value, value.Format(httpDateLayout)
+}
+
+// name returns HTTP-date.
+func (t HTTPDateType) name() string {
+	return "HTTP-date"
+}
+
+// parse parses the string as IMF-fixdate and, depending on the
+// AllowObsoleteFormats option, the obsolete formats.
+func (t HTTPDateType) parse(s string) (time.Time, error) {
+	return parseHTTPDate(s, t.AllowObsoleteFormats, t.referenceTime())
+}
+
+// referenceTime returns the ReferenceTime option or the current time if it
+// is not set.
+func (t HTTPDateType) referenceTime() time.Time {
+	if t.ReferenceTime.IsZero() {
+		return time.Now()
+	}
+
+	return t.ReferenceTime
+}
+
+// suggestion returns the IMF-fixdate string of a valid obsolete format string
+// when obsolete formats are not allowed.
+func (t HTTPDateType) suggestion(s string) string {
+	if t.AllowObsoleteFormats {
+		return ""
+	}
+
+	value, err := parseHTTPDate(s, true, t.referenceTime())
+
+	if err != nil {
+		return ""
+	}
+
+	return value.Format(httpDateLayout)
+}
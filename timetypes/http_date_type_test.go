@@ -0,0 +1,548 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestHTTPDateTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.HTTPDateType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.HTTPDateType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.HTTPDateType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.HTTPDateType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.HTTPDateType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.HTTPDateType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.HTTPDateType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.HTTPDateType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.HTTPDateType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.HTTPDateType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.HTTPDateType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.HTTPDateType": {
+			typ:      timetypes.HTTPDateType{},
+			other:    timetypes.HTTPDateType{},
+			expected: true,
+		},
+		"timetypes.HTTPDateType-different-options": {
+			typ:      timetypes.HTTPDateType{},
+			other:    timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.HTTPDateType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.HTTPDateType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.HTTPDateType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.HTTPDateType{},
+			expected: "timetypes.HTTPDateType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.HTTPDateType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.HTTPDateType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.HTTPDateType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP-date Terraform Value",
+					"An unexpected error occurred while attempting to read a HTTP-date string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-asctime": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sun Nov  6 08:49:37 1994"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP-date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be HTTP-date format. "+
+						"The HTTP-date string format is IMF-fixdate, such as Sun, 06 Nov 1994 08:49:37 GMT.\n\n"+
+						"Invalid IMF-fixdate at character 4, expected \",\":\n\n"+
+						"    Sun Nov  6 08:49:37 1994\n"+
+						"       ^\n\n"+
+						"Did you mean Sun, 06 Nov 1994 08:49:37 GMT?",
+				),
+			},
+		},
+		"string-value-asctime-allow-obsolete": {
+			typ:            timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sun Nov  6 08:49:37 1994"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-asctime-allow-obsolete-day-single-digit": {
+			typ:            timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sun Nov 6 08:49:37 1994"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP-date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be HTTP-date format. "+
+						"The HTTP-date string format is IMF-fixdate, such as Sun, 06 Nov 1994 08:49:37 GMT. "+
+						"The obsolete RFC 850 format, such as Sunday, 06-Nov-94 08:49:37 GMT, and asctime format, such as Sun Nov  6 08:49:37 1994, are also accepted.\n\n"+
+						"Invalid day at character 10, expected 2 digits:\n\n"+
+						"    Sun Nov 6 08:49:37 1994\n"+
+						"             ^",
+				),
+			},
+		},
+		"string-value-day-name-mismatch": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Mon, 06 Nov 1994 08:49:37 GMT"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP-date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be HTTP-date format. "+
+						"The HTTP-date string format is IMF-fixdate, such as Sun, 06 Nov 1994 08:49:37 GMT.\n\n"+
+						"Invalid day-name at character 1, expected \"Sun\", the day of the week of 1994-11-06:\n\n"+
+						"    Mon, 06 Nov 1994 08:49:37 GMT\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-imf-fixdate": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sun, 06 Nov 1994 08:49:37 GMT"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-imf-fixdate-allow-obsolete": {
+			typ:            timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sun, 06 Nov 1994 08:49:37 GMT"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-rfc850": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sunday, 06-Nov-94 08:49:37 GMT"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP-date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be HTTP-date format. "+
+						"The HTTP-date string format is IMF-fixdate, such as Sun, 06 Nov 1994 08:49:37 GMT.\n\n"+
+						"Invalid IMF-fixdate at character 4, expected \",\":\n\n"+
+						"    Sunday, 06-Nov-94 08:49:37 GMT\n"+
+						"       ^\n\n"+
+						"Did you mean Sun, 06 Nov 1994 08:49:37 GMT?",
+				),
+			},
+		},
+		"string-value-rfc850-allow-obsolete": {
+			typ:            timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sunday, 06-Nov-94 08:49:37 GMT"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-rfc850-allow-obsolete-day-name-mismatch": {
+			typ:            timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			terraformValue: tftypes.NewValue(tftypes.String, "Monday, 06-Nov-94 08:49:37 GMT"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid HTTP-date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be HTTP-date format. "+
+						"The HTTP-date string format is IMF-fixdate, such as Sun, 06 Nov 1994 08:49:37 GMT. "+
+						"The obsolete RFC 850 format, such as Sunday, 06-Nov-94 08:49:37 GMT, and asctime format, such as Sun Nov  6 08:49:37 1994, are also accepted.\n\n"+
+						"Invalid day-name-l at character 1, expected \"Sunday\", the day of the week of 1994-11-06:\n\n"+
+						"    Monday, 06-Nov-94 08:49:37 GMT\n"+
+						"    ^",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.HTTPDateType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.HTTPDateType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.HTTPDateNull(),
+		},
+		"unknown": {
+			typ:         timetypes.HTTPDateType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.HTTPDateUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.HTTPDateType{},
+			stringValue: types.StringValue("1994-11-06T08:49:37Z"),
+			expected:    timetypes.HTTPDateUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid HTTP-date String Value",
+					"An unexpected error occurred while converting a string value that was expected to be HTTP-date format. "+
+						"The HTTP-date string format is IMF-fixdate, such as Sun, 06 Nov 1994 08:49:37 GMT.\n\n"+
+						"Invalid day-name at character 1, expected \"Sun\", \"Mon\", \"Tue\", \"Wed\", \"Thu\", \"Fri\", or \"Sat\":\n\n"+
+						"    1994-11-06T08:49:37Z\n"+
+						"    ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.HTTPDateType{},
+			stringValue: types.StringValue("Sun, 06 Nov 1994 08:49:37 GMT"),
+			expected:    testValue(t, timetypes.HTTPDateString, "Sun, 06 Nov 1994 08:49:37 GMT"),
+		},
+		"value-valid-allow-obsolete": {
+			typ:         timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			stringValue: types.StringValue("Sun Nov  6 08:49:37 1994"),
+			expected:    testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Sun Nov  6 08:49:37 1994"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.HTTPDateType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.HTTPDateUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.HTTPDateNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.HTTPDateUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sunday, 06-Nov-94 08:49:37 GMT"),
+			expected:       timetypes.HTTPDateUnknown(),
+			expectedError:  fmt.Errorf(`parsing "Sunday, 06-Nov-94 08:49:37 GMT" as HTTP-date: invalid IMF-fixdate at offset 3: expected ","`),
+		},
+		"string-value-valid": {
+			typ:            timetypes.HTTPDateType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sun, 06 Nov 1994 08:49:37 GMT"),
+			expected:       testValue(t, timetypes.HTTPDateString, "Sun, 06 Nov 1994 08:49:37 GMT"),
+		},
+		"string-value-valid-allow-obsolete": {
+			typ:            timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			terraformValue: tftypes.NewValue(tftypes.String, "Sunday, 06-Nov-94 08:49:37 GMT"),
+			expected:       testValueFromString[timetypes.HTTPDate](t, timetypes.HTTPDateType{AllowObsoleteFormats: true}, "Sunday, 06-Nov-94 08:49:37 GMT"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPDateTypeValueFromTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.HTTPDateType
+		value    time.Time
+		expected timetypes.HTTPDate
+	}{
+		"fractional-seconds": {
+			typ:      timetypes.HTTPDateType{},
+			value:    time.Date(1994, 11, 6, 8, 49, 37, 999999999, time.UTC),
+			expected: testValue(t, timetypes.HTTPDateString, "Sun, 06 Nov 1994 08:49:37 GMT"),
+		},
+		"offset": {
+			typ:      timetypes.HTTPDateType{AllowObsoleteFormats: true},
+			value:    time.Date(1994, 11, 6, 1, 49, 37, 0, time.FixedZone("", -7*60*60)),
+			expected: testValue(t, timetypes.HTTPDateString, "Sun, 06 Nov 1994 08:49:37 GMT"),
+		},
+		"utc": {
+			typ:      timetypes.HTTPDateType{},
+			value:    time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC),
+			expected: testValue(t, timetypes.HTTPDateString, "Sun, 06 Nov 1994 08:49:37 GMT"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueFromTime(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if !got.Time().Equal(testCase.expected.Time()) {
+				t.Errorf("expected time %s, got: %s", testCase.expected.Time(), got.Time())
+			}
+		})
+	}
+}
+
+func TestHTTPDateTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.HTTPDateType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.HTTPDateType{},
+			expected: timetypes.HTTPDate{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package timetypes
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = ISO8601Duration{}
+	_ basetypes.StringValuable                   = ISO8601Duration{}
+	_ basetypes.StringValuableWithSemanticEquals = ISO8601Duration{}
+)
+
+// ISO8601DurationNull returns a null ISO8601Duration.
+func ISO8601DurationNull() ISO8601Duration {
+	return ISO8601Duration{
+		stringValue: stringValue{
+			null: true,
+		},
+	}
+}
+
+// ISO8601DurationString returns a known ISO8601Duration or any errors while
+// attempting to parse the string as ISO 8601 duration format.
+func ISO8601DurationString(s string, schemaPath path.Path) (ISO8601Duration, diag.Diagnostics) {
+	components, err := parseISO8601Duration(s)
+
+	if err != nil {
+		return ISO8601Duration{
+				stringValue: stringValue{
+					unknown: true,
+				},
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						parseErrorDetail(err),
+				),
+			}
+	}
+
+	return ISO8601Duration{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		components: components,
+	}, nil
+}
+
+// ISO8601DurationUnknown returns an unknown ISO8601Duration.
+func ISO8601DurationUnknown() ISO8601Duration {
+	return ISO8601Duration{
+		stringValue: stringValue{
+			unknown: true,
+		},
+	}
+}
+
+// ISO8601Duration implements the attr.Value interface for usage in logic. It
+// represents an ISO 8601 duration as its separate components, since years,
+// months, weeks, and days are calendar dependent and cannot be converted to a
+// fixed length of time.
+type ISO8601Duration struct {
+	stringValue
+
+	components iso8601DurationComponents
+}
+
+// AddTo returns the RFC3339 resulting from applying the ISO8601Duration to
+// the given RFC3339, keeping its time zone offset. Years and months are added
+// to the calendar date first, limiting the day to the last day of the
+// resulting month, such as 2023-01-31 plus P1M being 2023-02-28. Weeks and
+// days are then added to the calendar date, followed by hours, minutes, and
+// seconds as elapsed time. A null or unknown ISO8601Duration or RFC3339
+// returns a null or unknown RFC3339.
+//
+// Fractional years must be a whole number of months, such as P1.5Y, and
+// fractional months are not supported, since the length of a partial month
+// is ambiguous. Fractional weeks and days are applied as 24 hour days.
+func (v ISO8601Duration) AddTo(value RFC3339) (RFC3339, diag.Diagnostics) {
+	if v.null || value.null {
+		return RFC3339Null(), nil
+	}
+
+	if v.unknown || value.unknown {
+		return RFC3339Unknown(), nil
+	}
+
+	t, problem := v.addTime(value, 1)
+
+	if problem != "" {
+		return RFC3339Unknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"ISO 8601 Duration Calculation Error",
+				"An unexpected error occurred while adding an ISO 8601 duration to an RFC 3339 timestamp. "+
+					"Please contact the provider developers with the following:\n\n"+
+					problem,
+			),
+		}
+	}
+
+	return RFC3339Time(t)
+}
+
+// Days returns the days component of the ISO8601Duration.
+func (v ISO8601Duration) Days() float64 {
+	return v.components.days
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is an ISO8601Duration type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented components instead.
+func (v ISO8601Duration) Equal(o attr.Value) bool {
+	otherValue, ok := o.(ISO8601Duration)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// Hours returns the hours component of the ISO8601Duration.
+func (v ISO8601Duration) Hours() float64 {
+	return v.components.hours
+}
+
+// Minutes returns the minutes component of the ISO8601Duration.
+func (v ISO8601Duration) Minutes() float64 {
+	return v.components.minutes
+}
+
+// Months returns the months component of the ISO8601Duration. Years are not
+// included.
+func (v ISO8601Duration) Months() float64 {
+	return v.components.months
+}
+
+// Seconds returns the seconds component of the ISO8601Duration, including
+// any decimal fraction.
+func (v ISO8601Duration) Seconds() float64 {
+	return v.components.seconds
+}
+
+// StringSemanticEquals returns true if the given ISO8601Duration has the same
+// components, regardless of the string representation, such as P1Y and
+// P1Y0M0D or PT1.50S and PT1,5S. Differing components of the same length are
+// not equal, such as PT1H and PT60M, to match the original intent. The
+// framework calls this method to keep the prior value and prevent unexpected
+// differences.
+func (v ISO8601Duration) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ISO8601Duration)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.components == newValue.components, diags
+}
+
+// SubtractFrom returns the RFC3339 resulting from applying the
+// ISO8601Duration backwards from the given RFC3339, keeping its time zone
+// offset. The components are subtracted in the same order as AddTo, such as
+// 2023-03-31 minus P1M being 2023-02-28. A null or unknown ISO8601Duration or
+// RFC3339 returns a null or unknown RFC3339.
+func (v ISO8601Duration) SubtractFrom(value RFC3339) (RFC3339, diag.Diagnostics) {
+	if v.null || value.null {
+		return RFC3339Null(), nil
+	}
+
+	if v.unknown || value.unknown {
+		return RFC3339Unknown(), nil
+	}
+
+	t, problem := v.addTime(value, -1)
+
+	if problem != "" {
+		return RFC3339Unknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"ISO 8601 Duration Calculation Error",
+				"An unexpected error occurred while subtracting an ISO 8601 duration from an RFC 3339 timestamp. "+
+					"Please contact the provider developers with the following:\n\n"+
+					problem,
+			),
+		}
+	}
+
+	return RFC3339Time(t)
+}
+
+// Type returns the attr.Type of ISO8601Duration.
+func (v ISO8601Duration) Type(_ context.Context) attr.Type {
+	return ISO8601DurationType{}
+}
+
+// Weeks returns the weeks component of the ISO8601Duration. Days are not
+// included.
+func (v ISO8601Duration) Weeks() float64 {
+	return v.components.weeks
+}
+
+// Years returns the years component of the ISO8601Duration.
+func (v ISO8601Duration) Years() float64 {
+	return v.components.years
+}
+
+// addTime returns the time.Time resulting from applying the ISO8601Duration
+// forwards, when sign is 1, or backwards, when sign is -1, from the given
+// known RFC3339, following the rules of AddTo. A description of the problem,
+// suitable for diagnostics, is returned if the ISO8601Duration cannot be
+// applied.
+func (v ISO8601Duration) addTime(value RFC3339, sign int) (time.Time, string) {
+	months := v.components.years*12 + v.components.months
+
+	if months != math.Trunc(months) {
+		return time.Time{}, "Duration " + v.valueString + " has a fraction of a month, which has an ambiguous length."
+	}
+
+	days := v.components.weeks*7 + v.components.days
+	wholeDays := math.Trunc(days)
+	nanoseconds := (days-wholeDays)*float64(24*time.Hour) +
+		v.components.hours*float64(time.Hour) +
+		v.components.minutes*float64(time.Minute) +
+		v.components.seconds*float64(time.Second)
+
+	if months > math.MaxInt32 || wholeDays > math.MaxInt32 || nanoseconds > math.MaxInt64 {
+		if sign < 0 {
+			return time.Time{}, "Duration " + v.valueString + " is too large to subtract."
+		}
+
+		return time.Time{}, "Duration " + v.valueString + " is too large to add."
+	}
+
+	t := value.value
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	// Avoid time.Time AddDate normalization, which would overflow into the
+	// following month, such as 2023-01-31 plus one month being 2023-03-03.
+	month0 := int(month) - 1 + sign*int(months)
+	year += month0 / 12
+	month0 %= 12
+
+	if month0 < 0 {
+		month0 += 12
+		year--
+	}
+
+	month = time.Month(month0 + 1)
+
+	if lastDay := daysIn(year, int(month)); day > lastDay {
+		day = lastDay
+	}
+
+	result := time.Date(year, month, day, hour, minute, second, t.Nanosecond(), t.Location())
+	result = result.AddDate(0, 0, sign*int(wholeDays))
+	result = result.Add(time.Duration(sign) * time.Duration(math.Round(nanoseconds)))
+
+	if result.Year() > 9999 {
+		return time.Time{}, "Adding duration " + v.valueString + " to " + value.valueString + " results in a year after 9999, which RFC 3339 cannot represent."
+	}
+
+	if result.Year() < 0 {
+		return time.Time{}, "Subtracting duration " + v.valueString + " from " + value.valueString + " results in a year before 0000, which RFC 3339 cannot represent."
+	}
+
+	return result, ""
+}
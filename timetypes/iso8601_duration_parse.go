@@ -0,0 +1,227 @@
+package timetypes
+
+import (
+	"strconv"
+	"strings"
+)
+
+// iso8601DurationComponents are the component values of an ISO 8601
+// duration. Only the lowest order component present may be fractional.
+type iso8601DurationComponents struct {
+	years   float64
+	months  float64
+	weeks   float64
+	days    float64
+	hours   float64
+	minutes float64
+	seconds float64
+}
+
+// iso8601DurationDesignator is a component designator character and the
+// grammar component name used in errors.
+type iso8601DurationDesignator struct {
+	designator byte
+	component  string
+}
+
+// iso8601DurationDateDesignators are the allowed designators before the
+// "T" time designator, in order.
+var iso8601DurationDateDesignators = []iso8601DurationDesignator{
+	{designator: 'Y', component: "dur-year"},
+	{designator: 'M', component: "dur-month"},
+	{designator: 'W', component: "dur-week"},
+	{designator: 'D', component: "dur-day"},
+}
+
+// iso8601DurationTimeDesignators are the allowed designators after the "T"
+// time designator, in order.
+var iso8601DurationTimeDesignators = []iso8601DurationDesignator{
+	{designator: 'H', component: "dur-hour"},
+	{designator: 'M', component: "dur-minute"},
+	{designator: 'S', component: "dur-second"},
+}
+
+// parseISO8601Duration parses a string using the ISO 8601 duration format
+// with designators, PnYnMnWnDTnHnMnS. Any component may be omitted, but at
+// least one must be present and components must be in order. Weeks may be
+// combined with the other components, such as P1Y2W, as ISO 8601-2 permits,
+// rather than only appearing alone as in the RFC 3339 Appendix A grammar,
+// where dur-week is an alternative to dur-date and dur-time. The lowest
+// order component present may have a decimal fraction, using either a
+// period or comma decimal sign. The alternative PYYYY-MM-DDThh:mm:ss format
+// and negative durations are not supported. Any returned error is a
+// *ParseError.
+func parseISO8601Duration(s string) (iso8601DurationComponents, error) {
+	p := &iso8601DurationParser{
+		input: s,
+	}
+
+	return p.duration()
+}
+
+// iso8601DurationParser is a parser for the ISO 8601 duration format. The
+// component names in errors follow the RFC 3339 Appendix A grammar, although
+// weeks are accepted with other components.
+type iso8601DurationParser struct {
+	input  string
+	offset int
+}
+
+// duration parses: duration = "P" [dur-year] [dur-month] [dur-week] [dur-day]
+// ["T" [dur-hour] [dur-minute] [dur-second]], with at least one component
+// and at least one time component after "T"
+func (p *iso8601DurationParser) duration() (iso8601DurationComponents, error) {
+	var components iso8601DurationComponents
+
+	if p.offset >= len(p.input) || p.input[p.offset] != 'P' {
+		return components, p.errorf("duration", `"P"`)
+	}
+
+	p.offset++
+
+	values := []*float64{&components.years, &components.months, &components.weeks, &components.days}
+	designators := iso8601DurationDateDesignators
+	component := "duration"
+	next := 0
+	needComponent := true
+
+	for p.offset < len(p.input) || needComponent {
+		if p.offset < len(p.input) && p.input[p.offset] == 'T' && component != "dur-time" {
+			p.offset++
+
+			values = []*float64{&components.hours, &components.minutes, &components.seconds}
+			designators = iso8601DurationTimeDesignators
+			component = "dur-time"
+			next = 0
+			needComponent = true
+
+			continue
+		}
+
+		if p.offset >= len(p.input) || !isDigit(p.input[p.offset]) || next == len(designators) {
+			var expected []string
+
+			if next < len(designators) {
+				expected = append(expected, "one or more digits")
+			}
+
+			if component != "dur-time" {
+				expected = append(expected, `"T"`)
+			}
+
+			if !needComponent {
+				expected = append(expected, "end of string")
+			}
+
+			return components, p.errorf(component, joinExpected(expected))
+		}
+
+		value, fractional, err := p.number(component)
+
+		if err != nil {
+			return components, err
+		}
+
+		index := -1
+
+		if p.offset < len(p.input) {
+			for i := next; i < len(designators); i++ {
+				if designators[i].designator == p.input[p.offset] {
+					index = i
+
+					break
+				}
+			}
+		}
+
+		if index == -1 {
+			expected := make([]string, 0, len(designators)-next)
+
+			for _, designator := range designators[next:] {
+				expected = append(expected, strconv.Quote(string(designator.designator)))
+			}
+
+			return components, p.errorf(component, joinExpected(expected))
+		}
+
+		p.offset++
+
+		*values[index] = value
+		next = index + 1
+		needComponent = false
+
+		if component == "duration" {
+			component = "dur-date"
+		}
+
+		if fractional && p.offset < len(p.input) {
+			return components, p.errorf(designators[index].component, "end of string, only the lowest order component may have a decimal fraction")
+		}
+	}
+
+	return components, nil
+}
+
+// number parses one or more digits with an optional decimal fraction, which
+// uses either a period or comma decimal sign.
+func (p *iso8601DurationParser) number(component string) (float64, bool, error) {
+	start := p.offset
+
+	for p.offset < len(p.input) && isDigit(p.input[p.offset]) {
+		p.offset++
+	}
+
+	fractional := false
+
+	if p.offset < len(p.input) && (p.input[p.offset] == '.' || p.input[p.offset] == ',') {
+		p.offset++
+
+		fractionStart := p.offset
+
+		for p.offset < len(p.input) && isDigit(p.input[p.offset]) {
+			p.offset++
+		}
+
+		if p.offset == fractionStart {
+			return 0, false, p.errorf(component, "one or more decimal fraction digits")
+		}
+
+		fractional = true
+	}
+
+	value, err := strconv.ParseFloat(strings.Replace(p.input[start:p.offset], ",", ".", 1), 64)
+
+	if err != nil {
+		p.offset = start
+
+		return 0, false, p.errorf(component, "a number within range")
+	}
+
+	return value, fractional, nil
+}
+
+// errorf returns a *ParseError for the component at the current offset.
+func (p *iso8601DurationParser) errorf(component string, expected string) error {
+	return &ParseError{
+		Format:    "ISO 8601 duration",
+		Input:     p.input,
+		Offset:    p.offset,
+		Component: component,
+		Expected:  expected,
+	}
+}
+
+// joinExpected returns the alternatives joined in English, such as a, b, or
+// c.
+func joinExpected(alternatives []string) string {
+	switch len(alternatives) {
+	case 0:
+		return ""
+	case 1:
+		return alternatives[0]
+	case 2:
+		return alternatives[0] + " or " + alternatives[1]
+	default:
+		return strings.Join(alternatives[:len(alternatives)-1], ", ") + ", or " + alternatives[len(alternatives)-1]
+	}
+}
@@ -0,0 +1,791 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestISO8601DurationAddTo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ISO8601Duration
+		rfc3339       timetypes.RFC3339
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.ISO8601DurationNull(),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-31T15:04:05Z"),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-31T15:04:05Z"),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"rfc3339-null": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1D"),
+			rfc3339:  timetypes.RFC3339Null(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"rfc3339-unknown": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1D"),
+			rfc3339:  timetypes.RFC3339Unknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value-all-components": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y2M1W3DT4H5M6.5S"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2024-03-11T04:05:06.5Z"),
+		},
+		"value-days": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1D"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-12-31T15:04:05+07:00"),
+			expected: testValue(t, timetypes.RFC3339String, "2024-01-01T15:04:05+07:00"),
+		},
+		"value-fractional-days": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1.5D"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-01-02T12:00:00Z"),
+		},
+		"value-fractional-months": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1.5M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISO 8601 Duration Calculation Error",
+					"An unexpected error occurred while adding an ISO 8601 duration to an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Duration P1.5M has a fraction of a month, which has an ambiguous length.",
+				),
+			},
+		},
+		"value-fractional-years": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1.5Y"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2024-07-01T00:00:00Z"),
+		},
+		"value-hours": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT36H"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-01-02T12:00:00Z"),
+		},
+		"value-months-end-of-month": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-31T15:04:05Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-02-28T15:04:05Z"),
+		},
+		"value-months-end-of-month-leap-year": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2024-01-31T15:04:05Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2024-02-29T15:04:05Z"),
+		},
+		"value-months-year-boundary": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P13M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-12-15T15:04:05Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2025-01-15T15:04:05Z"),
+		},
+		"value-weeks": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P2W"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-02-20T15:04:05Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-03-06T15:04:05Z"),
+		},
+		"value-years-leap-day": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2024-02-29T15:04:05Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2025-02-28T15:04:05Z"),
+		},
+		"value-year-overflow": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "9999-06-01T00:00:00Z"),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISO 8601 Duration Calculation Error",
+					"An unexpected error occurred while adding an ISO 8601 duration to an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Adding duration P1Y to 9999-06-01T00:00:00Z results in a year after 9999, which RFC 3339 cannot represent.",
+				),
+			},
+		},
+		"value-too-large": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT9999999999999H"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISO 8601 Duration Calculation Error",
+					"An unexpected error occurred while adding an ISO 8601 duration to an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Duration PT9999999999999H is too large to add.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.AddTo(testCase.rfc3339)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationComponents(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value           timetypes.ISO8601Duration
+		expectedYears   float64
+		expectedMonths  float64
+		expectedWeeks   float64
+		expectedDays    float64
+		expectedHours   float64
+		expectedMinutes float64
+		expectedSeconds float64
+	}{
+		"null": {
+			value: timetypes.ISO8601DurationNull(),
+		},
+		"unknown": {
+			value: timetypes.ISO8601DurationUnknown(),
+		},
+		"value-all-components": {
+			value:           testValue(t, timetypes.ISO8601DurationString, "P1Y2M3W4DT5H6M7S"),
+			expectedYears:   1,
+			expectedMonths:  2,
+			expectedWeeks:   3,
+			expectedDays:    4,
+			expectedHours:   5,
+			expectedMinutes: 6,
+			expectedSeconds: 7,
+		},
+		"value-date": {
+			value:          testValue(t, timetypes.ISO8601DurationString, "P1Y2M"),
+			expectedYears:  1,
+			expectedMonths: 2,
+		},
+		"value-fractional-comma": {
+			value:           testValue(t, timetypes.ISO8601DurationString, "PT1H0,5M"),
+			expectedHours:   1,
+			expectedMinutes: 0.5,
+		},
+		"value-fractional-period": {
+			value:           testValue(t, timetypes.ISO8601DurationString, "PT1.25S"),
+			expectedSeconds: 1.25,
+		},
+		"value-time": {
+			value:         testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			expectedHours: 1,
+		},
+		"value-weeks": {
+			value:         testValue(t, timetypes.ISO8601DurationString, "P2W"),
+			expectedWeeks: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.value.Years(), testCase.expectedYears); diff != "" {
+				t.Errorf("unexpected years difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Months(), testCase.expectedMonths); diff != "" {
+				t.Errorf("unexpected months difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Weeks(), testCase.expectedWeeks); diff != "" {
+				t.Errorf("unexpected weeks difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Days(), testCase.expectedDays); diff != "" {
+				t.Errorf("unexpected days difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Hours(), testCase.expectedHours); diff != "" {
+				t.Errorf("unexpected hours difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Minutes(), testCase.expectedMinutes); diff != "" {
+				t.Errorf("unexpected minutes difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Seconds(), testCase.expectedSeconds); diff != "" {
+				t.Errorf("unexpected seconds difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Duration
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.ISO8601DurationNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.ISO8601Duration": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			other:    types.StringValue("PT1H"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.ISO8601DurationNull(),
+			other:    timetypes.ISO8601DurationNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.ISO8601DurationNull(),
+			other:    timetypes.ISO8601DurationUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.ISO8601DurationNull(),
+			other:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			other:    timetypes.ISO8601DurationNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			other:    timetypes.ISO8601DurationUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			other:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			other:    timetypes.ISO8601DurationNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			other:    timetypes.ISO8601DurationUnknown(),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			other:    testValue(t, timetypes.ISO8601DurationString, "PT2H"),
+			expected: false,
+		},
+		"value-value-different-string-same-components": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y"),
+			other:    testValue(t, timetypes.ISO8601DurationString, "P1Y0M"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y2M"),
+			other:    testValue(t, timetypes.ISO8601DurationString, "P1Y2M"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Duration
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ISO8601DurationNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT30S"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Duration
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ISO8601DurationNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT30S"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ISO8601Duration
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.ISO8601Duration": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			newValue: types.StringValue("PT1H"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ISO8601Duration\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-decimal-sign": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1.50S"),
+			newValue: testValue(t, timetypes.ISO8601DurationString, "PT1,5S"),
+			expected: true,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1D"),
+			newValue: testValue(t, timetypes.ISO8601DurationString, "P2D"),
+			expected: false,
+		},
+		"value-value-different-components": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			newValue: testValue(t, timetypes.ISO8601DurationString, "PT60M"),
+			expected: false,
+		},
+		"value-value-different-days-hours": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1D"),
+			newValue: testValue(t, timetypes.ISO8601DurationString, "PT24H"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y2M"),
+			newValue: testValue(t, timetypes.ISO8601DurationString, "P1Y2M"),
+			expected: true,
+		},
+		"value-value-zero-components": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y"),
+			newValue: testValue(t, timetypes.ISO8601DurationString, "P1Y0M0DT0H"),
+			expected: true,
+		},
+		"value-value-zero-duration": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P0D"),
+			newValue: testValue(t, timetypes.ISO8601DurationString, "PT0S"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Duration
+		expected string
+	}{
+		"null": {
+			value:    timetypes.ISO8601DurationNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y2M"),
+			expected: "\"P1Y2M\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationSubtractFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ISO8601Duration
+		rfc3339       timetypes.RFC3339
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.ISO8601DurationNull(),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-03-31T15:04:05Z"),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-03-31T15:04:05Z"),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"rfc3339-null": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1D"),
+			rfc3339:  timetypes.RFC3339Null(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"rfc3339-unknown": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1D"),
+			rfc3339:  timetypes.RFC3339Unknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value-all-components": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y2M1W3DT4H5M6.5S"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2024-03-11T04:05:06.5Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+		},
+		"value-days": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1D"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2024-01-01T15:04:05+07:00"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-12-31T15:04:05+07:00"),
+		},
+		"value-fractional-months": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1.5M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISO 8601 Duration Calculation Error",
+					"An unexpected error occurred while subtracting an ISO 8601 duration from an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Duration P1.5M has a fraction of a month, which has an ambiguous length.",
+				),
+			},
+		},
+		"value-hours": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT36H"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-02T12:00:00Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+		},
+		"value-months-end-of-month": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-03-31T15:04:05Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-02-28T15:04:05Z"),
+		},
+		"value-months-year-boundary": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P13M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2025-01-15T15:04:05Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-12-15T15:04:05Z"),
+		},
+		"value-year-underflow": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "P1Y"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "0000-06-01T00:00:00Z"),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISO 8601 Duration Calculation Error",
+					"An unexpected error occurred while subtracting an ISO 8601 duration from an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Subtracting duration P1Y from 0000-06-01T00:00:00Z results in a year before 0000, which RFC 3339 cannot represent.",
+				),
+			},
+		},
+		"value-too-large": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT9999999999999H"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ISO 8601 Duration Calculation Error",
+					"An unexpected error occurred while subtracting an ISO 8601 duration from an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Duration PT9999999999999H is too large to subtract.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.SubtractFrom(testCase.rfc3339)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ISO8601Duration
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.ISO8601DurationNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			expected: types.StringValue("PT1H"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ISO8601Duration
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.ISO8601DurationNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1H"),
+			expected: tftypes.NewValue(tftypes.String, "PT1H"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Duration
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.ISO8601DurationNull(),
+			expected: timetypes.ISO8601DurationType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Duration
+		expected string
+	}{
+		"null": {
+			value:    timetypes.ISO8601DurationNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.ISO8601DurationUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601DurationString, "PT1,5S"),
+			expected: "PT1,5S",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
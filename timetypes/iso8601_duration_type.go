@@ -0,0 +1,126 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = ISO8601DurationType{}
+	_ attr.Type                    = ISO8601DurationType{}
+	_ basetypes.StringTypable      = ISO8601DurationType{}
+	_ xattr.TypeWithValidate       = ISO8601DurationType{}
+)
+
+// ISO8601DurationType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are ISO 8601 duration strings, such as
+// PT1H, P1D, or P1Y2M.
+type ISO8601DurationType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t ISO8601DurationType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is ISO8601DurationType.
+func (t ISO8601DurationType) Equal(o attr.Type) bool {
+	_, ok := o.(ISO8601DurationType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t ISO8601DurationType) String() string {
+	return "timetypes.ISO8601DurationType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t ISO8601DurationType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always ISO 8601 duration conformant.
+func (t ISO8601DurationType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid ISO 8601 Duration Terraform Value",
+				"An unexpected error occurred while attempting to read an ISO 8601 duration string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := ISO8601DurationString(str, schemaPath)
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t ISO8601DurationType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return ISO8601DurationNull(), nil
+	}
+
+	if in.IsUnknown() {
+		return ISO8601DurationUnknown(), nil
+	}
+
+	return ISO8601DurationString(in.ValueString(), path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t ISO8601DurationType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return ISO8601DurationNull(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return ISO8601DurationUnknown(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return ISO8601DurationUnknown(), err
+	}
+
+	components, err := parseISO8601Duration(str)
+
+	if err != nil {
+		return ISO8601DurationUnknown(), err
+	}
+
+	return ISO8601Duration{
+		stringValue: stringValue{
+			valueString: str,
+		},
+		components: components,
+	}, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t ISO8601DurationType) ValueType(_ context.Context) attr.Value {
+	return ISO8601Duration{}
+}
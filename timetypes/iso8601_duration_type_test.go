@@ -0,0 +1,595 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestISO8601DurationTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.ISO8601DurationType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.ISO8601DurationType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.ISO8601DurationType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.ISO8601DurationType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.ISO8601DurationType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.ISO8601DurationType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.ISO8601DurationType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.ISO8601DurationType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.ISO8601DurationType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ISO8601DurationType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.ISO8601DurationType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.ISO8601DurationType": {
+			typ:      timetypes.ISO8601DurationType{},
+			other:    timetypes.ISO8601DurationType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.ISO8601DurationType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.ISO8601DurationType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ISO8601DurationType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.ISO8601DurationType{},
+			expected: "timetypes.ISO8601DurationType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ISO8601DurationType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.ISO8601DurationType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.ISO8601DurationType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration Terraform Value",
+					"An unexpected error occurred while attempting to read an ISO 8601 duration string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-duration-format"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid duration at character 1, expected \"P\":\n\n"+
+						"    not-duration-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-designator-order": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1D1Y"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid dur-date at character 4, expected \"T\" or end of string:\n\n"+
+						"    P1D1Y\n"+
+						"       ^",
+				),
+			},
+		},
+		"string-value-invalid-empty": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid duration at character 2, expected one or more digits or \"T\":\n\n"+
+						"    P\n"+
+						"     ^",
+				),
+			},
+		},
+		"string-value-invalid-empty-time": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1DT"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid dur-time at character 5, expected one or more digits:\n\n"+
+						"    P1DT\n"+
+						"        ^",
+				),
+			},
+		},
+		"string-value-invalid-fraction-not-lowest": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1.5DT1H"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid dur-day at character 6, expected end of string, only the lowest order component may have a decimal fraction:\n\n"+
+						"    P1.5DT1H\n"+
+						"         ^",
+				),
+			},
+		},
+		"string-value-invalid-fraction-missing-digits": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "PT1.S"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid dur-time at character 5, expected one or more decimal fraction digits:\n\n"+
+						"    PT1.S\n"+
+						"        ^",
+				),
+			},
+		},
+		"string-value-invalid-go-duration": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1h30m"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid duration at character 1, expected \"P\":\n\n"+
+						"    1h30m\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-lowercase": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "p1d"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid duration at character 1, expected \"P\":\n\n"+
+						"    p1d\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-missing-designator": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid duration at character 3, expected \"Y\", \"M\", \"W\", or \"D\":\n\n"+
+						"    P1\n"+
+						"      ^",
+				),
+			},
+		},
+		"string-value-invalid-time-designator-missing": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1H"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid duration at character 3, expected \"Y\", \"M\", \"W\", or \"D\":\n\n"+
+						"    P1H\n"+
+						"      ^",
+				),
+			},
+		},
+		"string-value-valid-all-components": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1Y2M3W4DT5H6M7S"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-date": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1Y2M"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-days": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1D"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-comma": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "PT1,5H"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-period": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "PT0.5S"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-time": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "PT1H"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-weeks": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P2W"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-zero": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P0D"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.ISO8601DurationType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.ISO8601DurationType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.ISO8601DurationNull(),
+		},
+		"unknown": {
+			typ:         timetypes.ISO8601DurationType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.ISO8601DurationUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.ISO8601DurationType{},
+			stringValue: types.StringValue("not-duration-format"),
+			expected:    timetypes.ISO8601DurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid ISO 8601 Duration String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 duration format. "+
+						"The ISO 8601 duration string format is PnYnMnWnDTnHnMnS, where any component may be omitted, such as PT1H, P1D, or P1Y2M. "+
+						"The lowest order component may have a decimal fraction, such as PT1.5S.\n\n"+
+						"Invalid duration at character 1, expected \"P\":\n\n"+
+						"    not-duration-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.ISO8601DurationType{},
+			stringValue: types.StringValue("P1Y2M"),
+			expected:    testValue(t, timetypes.ISO8601DurationString, "P1Y2M"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.ISO8601DurationType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.ISO8601DurationUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.ISO8601DurationNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.ISO8601DurationUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-duration-format"),
+			expected:       timetypes.ISO8601DurationUnknown(),
+			expectedError:  fmt.Errorf(`parsing "not-duration-format" as ISO 8601 duration: invalid duration at offset 0: expected "P"`),
+		},
+		"string-value-valid": {
+			typ:            timetypes.ISO8601DurationType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1DT12H"),
+			expected:       testValue(t, timetypes.ISO8601DurationString, "P1DT12H"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601DurationTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ISO8601DurationType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.ISO8601DurationType{},
+			expected: timetypes.ISO8601Duration{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
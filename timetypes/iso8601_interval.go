@@ -0,0 +1,201 @@
+package timetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = ISO8601Interval{}
+	_ basetypes.StringValuable                   = ISO8601Interval{}
+	_ basetypes.StringValuableWithSemanticEquals = ISO8601Interval{}
+)
+
+// ISO8601IntervalNull returns a null ISO8601Interval.
+func ISO8601IntervalNull() ISO8601Interval {
+	return ISO8601Interval{
+		stringValue: stringValue{
+			null: true,
+		},
+	}
+}
+
+// ISO8601IntervalString returns a known ISO8601Interval or any errors while
+// attempting to parse the string as ISO 8601 interval format.
+func ISO8601IntervalString(s string, schemaPath path.Path) (ISO8601Interval, diag.Diagnostics) {
+	value, err := parseISO8601Interval(s)
+
+	if err != nil {
+		return ISO8601Interval{
+				stringValue: stringValue{
+					unknown: true,
+				},
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						parseErrorDetail(err),
+				),
+			}
+	}
+
+	return value, nil
+}
+
+// ISO8601IntervalUnknown returns an unknown ISO8601Interval.
+func ISO8601IntervalUnknown() ISO8601Interval {
+	return ISO8601Interval{
+		stringValue: stringValue{
+			unknown: true,
+		},
+	}
+}
+
+// ISO8601Interval implements the attr.Value interface for usage in logic. It
+// represents an ISO 8601 time interval between a start and end instant in
+// time, such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z. An interval with
+// a duration, such as 2023-01-01T00:00:00Z/P1M, has the other end calculated
+// from the duration.
+//
+// The interval includes the start and excludes the end, so intervals where
+// one ends as the other starts, such as a month followed by the next month,
+// do not overlap.
+type ISO8601Interval struct {
+	stringValue
+
+	start RFC3339
+	end   RFC3339
+
+	// duration is the duration of the string representation, which is null
+	// when both the start and end are present.
+	duration ISO8601Duration
+}
+
+// Contains returns true if the given RFC3339 is at or after the start and
+// before the end of the ISO8601Interval. A null or unknown ISO8601Interval or
+// RFC3339 returns false.
+func (v ISO8601Interval) Contains(value RFC3339) bool {
+	if v.null || v.unknown || value.null || value.unknown {
+		return false
+	}
+
+	return !value.value.Before(v.start.value) && value.value.Before(v.end.value)
+}
+
+// ContainsInterval returns true if the given ISO8601Interval is entirely
+// within the ISO8601Interval, such as a day within its month. A null or
+// unknown ISO8601Interval returns false.
+func (v ISO8601Interval) ContainsInterval(o ISO8601Interval) bool {
+	if v.null || v.unknown || o.null || o.unknown {
+		return false
+	}
+
+	return !o.start.value.Before(v.start.value) && !o.end.value.After(v.end.value)
+}
+
+// Duration returns the ISO8601Duration of an ISO8601Interval with a
+// duration, such as P1M for 2023-01-01T00:00:00Z/P1M. A null ISO8601Duration
+// is returned for an ISO8601Interval with both a start and end or a null
+// ISO8601Interval. An unknown ISO8601Interval returns an unknown
+// ISO8601Duration.
+func (v ISO8601Interval) Duration() ISO8601Duration {
+	if v.null {
+		return ISO8601DurationNull()
+	}
+
+	if v.unknown {
+		return ISO8601DurationUnknown()
+	}
+
+	return v.duration
+}
+
+// End returns the end of an ISO8601Interval, which is calculated from the
+// duration for an ISO8601Interval with a start and duration. A null or
+// unknown ISO8601Interval returns a null or unknown RFC3339.
+func (v ISO8601Interval) End() RFC3339 {
+	if v.null {
+		return RFC3339Null()
+	}
+
+	if v.unknown {
+		return RFC3339Unknown()
+	}
+
+	return v.end
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is an ISO8601Interval type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented instants in time
+// instead.
+func (v ISO8601Interval) Equal(o attr.Value) bool {
+	otherValue, ok := o.(ISO8601Interval)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// Overlaps returns true if the ISO8601Interval and the given ISO8601Interval
+// share any instant in time. Intervals where one ends as the other starts do
+// not overlap. A null or unknown ISO8601Interval returns false.
+func (v ISO8601Interval) Overlaps(o ISO8601Interval) bool {
+	if v.null || v.unknown || o.null || o.unknown {
+		return false
+	}
+
+	return v.start.value.Before(o.end.value) && o.start.value.Before(v.end.value)
+}
+
+// Start returns the start of an ISO8601Interval, which is calculated from
+// the duration for an ISO8601Interval with a duration and end. A null or
+// unknown ISO8601Interval returns a null or unknown RFC3339.
+func (v ISO8601Interval) Start() RFC3339 {
+	if v.null {
+		return RFC3339Null()
+	}
+
+	if v.unknown {
+		return RFC3339Unknown()
+	}
+
+	return v.start
+}
+
+// StringSemanticEquals returns true if the given ISO8601Interval has the
+// same start and end instants in time, regardless of the string
+// representation, such as 2023-01-01T00:00:00Z/P1D and
+// 2023-01-01T00:00:00Z/2023-01-02T00:00:00Z. The framework calls this method
+// to keep the prior value and prevent unexpected differences.
+func (v ISO8601Interval) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ISO8601Interval)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.start.value.Equal(newValue.start.value) && v.end.value.Equal(newValue.end.value), diags
+}
+
+// Type returns the attr.Type of ISO8601Interval.
+func (v ISO8601Interval) Type(_ context.Context) attr.Type {
+	return ISO8601IntervalType{}
+}
@@ -0,0 +1,176 @@
+package timetypes
+
+import (
+	"errors"
+	"strings"
+)
+
+// parseISO8601Interval parses a string using the ISO 8601 time interval
+// format with a solidus separator, where each end is an RFC 3339 date-time
+// or one end is an ISO 8601 duration, such as start/end, start/duration, or
+// duration/end. The missing end is calculated from the duration, following
+// the rules of ISO8601Duration.AddTo and ISO8601Duration.SubtractFrom. The
+// start must be before the end. The alternative double hyphen separator,
+// abbreviated end date-times, and repeating intervals are not supported.
+// Grammar errors are a *ParseError, while other errors describe the problem
+// in a sentence suitable for diagnostics.
+func parseISO8601Interval(s string) (ISO8601Interval, error) {
+	separator := strings.IndexByte(s, '/')
+	first := s
+
+	if separator >= 0 {
+		first = s[:separator]
+	}
+
+	result := ISO8601Interval{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		start:    RFC3339Null(),
+		duration: ISO8601DurationNull(),
+		end:      RFC3339Null(),
+	}
+
+	if strings.HasPrefix(first, "P") {
+		duration, err := parseISO8601IntervalDuration(s, first, 0)
+
+		if err != nil {
+			return ISO8601IntervalUnknown(), err
+		}
+
+		result.duration = duration
+	} else {
+		start, err := parseISO8601IntervalDateTime(s, first, 0)
+
+		if err != nil {
+			return ISO8601IntervalUnknown(), err
+		}
+
+		result.start = start
+	}
+
+	if separator < 0 {
+		return ISO8601IntervalUnknown(), &ParseError{
+			Format:    "ISO 8601 interval",
+			Input:     s,
+			Offset:    len(s),
+			Component: "interval",
+			Expected:  `"/"`,
+		}
+	}
+
+	second := s[separator+1:]
+
+	if strings.HasPrefix(second, "P") {
+		if !result.duration.null {
+			return ISO8601IntervalUnknown(), &ParseError{
+				Format:    "ISO 8601 interval",
+				Input:     s,
+				Offset:    separator + 1,
+				Component: "interval",
+				Expected:  "date-time",
+			}
+		}
+
+		duration, err := parseISO8601IntervalDuration(s, second, separator+1)
+
+		if err != nil {
+			return ISO8601IntervalUnknown(), err
+		}
+
+		result.duration = duration
+	} else {
+		end, err := parseISO8601IntervalDateTime(s, second, separator+1)
+
+		if err != nil {
+			return ISO8601IntervalUnknown(), err
+		}
+
+		result.end = end
+	}
+
+	if result.start.null {
+		t, problem := result.duration.addTime(result.end, -1)
+
+		if problem != "" {
+			return ISO8601IntervalUnknown(), errors.New(problem)
+		}
+
+		// addTime ensures the year is within 0000 to 9999.
+		result.start = RFC3339{timestampTime(RFC3339Type{}, t)}
+	}
+
+	if result.end.null {
+		t, problem := result.duration.addTime(result.start, 1)
+
+		if problem != "" {
+			return ISO8601IntervalUnknown(), errors.New(problem)
+		}
+
+		// addTime ensures the year is within 0000 to 9999.
+		result.end = RFC3339{timestampTime(RFC3339Type{}, t)}
+	}
+
+	if !result.start.value.Before(result.end.value) {
+		return ISO8601IntervalUnknown(), errors.New("Start " + result.start.valueString + " is not before end " + result.end.valueString + ".")
+	}
+
+	return result, nil
+}
+
+// parseISO8601IntervalDateTime parses one end of an interval as an RFC 3339
+// date-time, where offset is the position of the end within the interval.
+// Any returned error is a *ParseError for the entire interval.
+func parseISO8601IntervalDateTime(interval string, s string, offset int) (RFC3339, error) {
+	t, err := ParseRFC3339(s)
+
+	if err != nil {
+		return RFC3339Unknown(), iso8601IntervalParseError(interval, offset, err)
+	}
+
+	return RFC3339{
+		timestamp{
+			stringValue: stringValue{
+				valueString: s,
+			},
+			value: t,
+		},
+	}, nil
+}
+
+// parseISO8601IntervalDuration parses one end of an interval as an ISO 8601
+// duration, where offset is the position of the duration within the
+// interval. Any returned error is a *ParseError for the entire interval.
+func parseISO8601IntervalDuration(interval string, s string, offset int) (ISO8601Duration, error) {
+	components, err := parseISO8601Duration(s)
+
+	if err != nil {
+		return ISO8601DurationUnknown(), iso8601IntervalParseError(interval, offset, err)
+	}
+
+	return ISO8601Duration{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		components: components,
+	}, nil
+}
+
+// iso8601IntervalParseError returns the *ParseError of one part of an
+// interval relative to the entire interval, so diagnostics point at the
+// invalid character within the interval.
+func iso8601IntervalParseError(interval string, offset int, err error) error {
+	var parseErr *ParseError
+
+	if !errors.As(err, &parseErr) {
+		return err
+	}
+
+	return &ParseError{
+		Format:    "ISO 8601 interval",
+		Input:     interval,
+		Offset:    offset + parseErr.Offset,
+		Component: parseErr.Component,
+		Expected:  parseErr.Expected,
+	}
+}
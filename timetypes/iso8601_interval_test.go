@@ -0,0 +1,805 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestISO8601IntervalContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		rfc3339  timetypes.RFC3339
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-15T00:00:00Z"),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-15T00:00:00Z"),
+			expected: false,
+		},
+		"rfc3339-null": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			rfc3339:  timetypes.RFC3339Null(),
+			expected: false,
+		},
+		"rfc3339-unknown": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			rfc3339:  timetypes.RFC3339Unknown(),
+			expected: false,
+		},
+		"value-after": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-02-15T00:00:00Z"),
+			expected: false,
+		},
+		"value-before": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2022-12-31T23:59:59Z"),
+			expected: false,
+		},
+		"value-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-02-01T00:00:00Z"),
+			expected: false,
+		},
+		"value-offset": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-01-02T00:00:00Z"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T20:00:00-05:00"),
+			expected: false,
+		},
+		"value-start": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00Z"),
+			expected: true,
+		},
+		"value-within": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-15T00:00:00Z"),
+			expected: true,
+		},
+		"value-within-offset": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-01-02T00:00:00Z"),
+			rfc3339:  testValue(t, timetypes.RFC3339String, "2023-01-01T18:00:00-05:00"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Contains(testCase.rfc3339)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalContainsInterval(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		other    timetypes.ISO8601Interval
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-02T00:00:00Z/P1D"),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-02T00:00:00Z/P1D"),
+			expected: false,
+		},
+		"other-null": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    timetypes.ISO8601IntervalNull(),
+			expected: false,
+		},
+		"other-unknown": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    timetypes.ISO8601IntervalUnknown(),
+			expected: false,
+		},
+		"value-equal": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z"),
+			expected: true,
+		},
+		"value-overlapping-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-31T00:00:00Z/P2D"),
+			expected: false,
+		},
+		"value-overlapping-start": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2022-12-31T00:00:00Z/P2D"),
+			expected: false,
+		},
+		"value-within": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-02T00:00:00Z/P1D"),
+			expected: true,
+		},
+		"value-within-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "P1D/2023-02-01T00:00:00Z"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ContainsInterval(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		expected timetypes.ISO8601Duration
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: timetypes.ISO8601DurationNull(),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: timetypes.ISO8601DurationUnknown(),
+		},
+		"value-duration-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "P1M/2023-02-01T00:00:00Z"),
+			expected: testValue(t, timetypes.ISO8601DurationString, "P1M"),
+		},
+		"value-start-duration": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H30M"),
+			expected: testValue(t, timetypes.ISO8601DurationString, "PT1H30M"),
+		},
+		"value-start-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z"),
+			expected: timetypes.ISO8601DurationNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Duration()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalEnd(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		expected timetypes.RFC3339
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value-duration-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "P1M/2023-02-01t00:00:00z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-02-01t00:00:00z"),
+		},
+		"value-start-duration": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-31T15:04:05+07:00/P1M"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-02-28T15:04:05+07:00"),
+		},
+		"value-start-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-02-01T00:00:00.000Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-02-01T00:00:00.000Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.End()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.ISO8601IntervalNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.ISO8601Interval": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H"),
+			other:    types.StringValue("2023-01-01T00:00:00Z/PT1H"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			other:    timetypes.ISO8601IntervalNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.ISO8601IntervalNull(),
+			other:    timetypes.ISO8601IntervalUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.ISO8601IntervalNull(),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			other:    timetypes.ISO8601IntervalNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			other:    timetypes.ISO8601IntervalUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H"),
+			other:    timetypes.ISO8601IntervalNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H"),
+			other:    timetypes.ISO8601IntervalUnknown(),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT2H"),
+			expected: false,
+		},
+		"value-value-different-string-same-interval": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1D"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-01-02T00:00:00Z"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT30S"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT30S"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalOverlaps(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		other    timetypes.ISO8601Interval
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-02T00:00:00Z/P1D"),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-02T00:00:00Z/P1D"),
+			expected: false,
+		},
+		"other-null": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    timetypes.ISO8601IntervalNull(),
+			expected: false,
+		},
+		"other-unknown": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    timetypes.ISO8601IntervalUnknown(),
+			expected: false,
+		},
+		"value-adjacent-after": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-02-01T00:00:00Z/P1M"),
+			expected: false,
+		},
+		"value-adjacent-before": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "P1M/2023-01-01T00:00:00Z"),
+			expected: false,
+		},
+		"value-after": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-03-01T00:00:00Z/P1M"),
+			expected: false,
+		},
+		"value-containing": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-02T00:00:00Z/P1D"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			expected: true,
+		},
+		"value-overlapping-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-31T00:00:00Z/P2D"),
+			expected: true,
+		},
+		"value-overlapping-start": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2022-12-31T00:00:00Z/P2D"),
+			expected: true,
+		},
+		"value-within": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			other:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-02T00:00:00Z/P1D"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Overlaps(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalStart(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		expected timetypes.RFC3339
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value-duration-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "P1M/2023-03-31T15:04:05-08:00"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-02-28T15:04:05-08:00"),
+		},
+		"value-start-duration": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01t00:00:00z/P1M"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-01-01t00:00:00z"),
+		},
+		"value-start-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00.5Z/2023-02-01T00:00:00Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2023-01-01T00:00:00.5Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Start()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ISO8601Interval
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.ISO8601Interval": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1D"),
+			newValue: types.StringValue("2023-01-01T00:00:00Z/P1D"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ISO8601Interval\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1D"),
+			newValue: testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P2D"),
+			expected: false,
+		},
+		"value-value-different-start": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z"),
+			newValue: testValue(t, timetypes.ISO8601IntervalString, "2023-01-02T00:00:00Z/2023-02-01T00:00:00Z"),
+			expected: false,
+		},
+		"value-value-duration-end": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "P1D/2023-01-02T00:00:00Z"),
+			newValue: testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-01-02T00:00:00Z"),
+			expected: true,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1D"),
+			newValue: testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1D"),
+			expected: true,
+		},
+		"value-value-offset": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/2023-01-02T00:00:00Z"),
+			newValue: testValue(t, timetypes.ISO8601IntervalString, "2022-12-31T19:00:00-05:00/2023-01-01T19:00:00-05:00"),
+			expected: true,
+		},
+		"value-value-start-duration": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1D"),
+			newValue: testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT24H"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		expected string
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+			expected: "\"2023-01-01T00:00:00Z/P1M\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ISO8601Interval
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H"),
+			expected: types.StringValue("2023-01-01T00:00:00Z/PT1H"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ISO8601Interval
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1H"),
+			expected: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z/PT1H"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: timetypes.ISO8601IntervalType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ISO8601Interval
+		expected string
+	}{
+		"null": {
+			value:    timetypes.ISO8601IntervalNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.ISO8601IntervalUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/PT1,5S"),
+			expected: "2023-01-01T00:00:00Z/PT1,5S",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = ISO8601IntervalType{}
+	_ attr.Type                    = ISO8601IntervalType{}
+	_ basetypes.StringTypable      = ISO8601IntervalType{}
+	_ xattr.TypeWithValidate       = ISO8601IntervalType{}
+)
+
+// ISO8601IntervalType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are ISO 8601 time interval strings
+// with RFC 3339 date-times and ISO 8601 durations, such as
+// 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or
+// P1M/2023-02-01T00:00:00Z.
+type ISO8601IntervalType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t ISO8601IntervalType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is ISO8601IntervalType.
+func (t ISO8601IntervalType) Equal(o attr.Type) bool {
+	_, ok := o.(ISO8601IntervalType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t ISO8601IntervalType) String() string {
+	return "timetypes.ISO8601IntervalType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t ISO8601IntervalType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always ISO 8601 interval conformant.
+func (t ISO8601IntervalType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid ISO 8601 Interval Terraform Value",
+				"An unexpected error occurred while attempting to read an ISO 8601 interval string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := ISO8601IntervalString(str, schemaPath)
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t ISO8601IntervalType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return ISO8601IntervalNull(), nil
+	}
+
+	if in.IsUnknown() {
+		return ISO8601IntervalUnknown(), nil
+	}
+
+	return ISO8601IntervalString(in.ValueString(), path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t ISO8601IntervalType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return ISO8601IntervalNull(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return ISO8601IntervalUnknown(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return ISO8601IntervalUnknown(), err
+	}
+
+	value, err := parseISO8601Interval(str)
+
+	if err != nil {
+		return ISO8601IntervalUnknown(), err
+	}
+
+	return value, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t ISO8601IntervalType) ValueType(_ context.Context) attr.Value {
+	return ISO8601Interval{}
+}
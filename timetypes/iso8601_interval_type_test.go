@@ -0,0 +1,604 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestISO8601IntervalTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.ISO8601IntervalType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.ISO8601IntervalType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.ISO8601IntervalType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.ISO8601IntervalType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.ISO8601IntervalType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.ISO8601IntervalType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.ISO8601IntervalType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.ISO8601IntervalType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.ISO8601IntervalType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ISO8601IntervalType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.ISO8601IntervalType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.ISO8601IntervalType": {
+			typ:      timetypes.ISO8601IntervalType{},
+			other:    timetypes.ISO8601IntervalType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.ISO8601IntervalType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.ISO8601IntervalType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ISO8601IntervalType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.ISO8601IntervalType{},
+			expected: "timetypes.ISO8601IntervalType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ISO8601IntervalType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.ISO8601IntervalType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.ISO8601IntervalType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval Terraform Value",
+					"An unexpected error occurred while attempting to read an ISO 8601 interval string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid-duration": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z/P1X"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid duration at character 24, expected \"Y\", \"M\", \"W\", or \"D\":\n\n"+
+						"    2023-01-01T00:00:00Z/P1X\n"+
+						"                           ^",
+				),
+			},
+		},
+		"string-value-invalid-durations": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1D/P1D"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid interval at character 5, expected date-time:\n\n"+
+						"    P1D/P1D\n"+
+						"        ^",
+				),
+			},
+		},
+		"string-value-invalid-empty": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, ""),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid date-fullyear at character 1, expected 4 digits:\n\n"+
+						"    \n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-end": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z/2023-02-30T00:00:00Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid date-mday at character 30, expected 01-28:\n\n"+
+						"    2023-01-01T00:00:00Z/2023-02-30T00:00:00Z\n"+
+						"                                 ^",
+				),
+			},
+		},
+		"string-value-invalid-end-before-start": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-02-01T00:00:00Z/2023-01-01T00:00:00Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Error: Start 2023-02-01T00:00:00Z is not before end 2023-01-01T00:00:00Z.",
+				),
+			},
+		},
+		"string-value-invalid-end-missing": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z/"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid date-fullyear at character 22, expected 4 digits:\n\n"+
+						"    2023-01-01T00:00:00Z/\n"+
+						"                         ^",
+				),
+			},
+		},
+		"string-value-invalid-fractional-months": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z/P1.5M"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Error: Duration P1.5M has a fraction of a month, which has an ambiguous length.",
+				),
+			},
+		},
+		"string-value-invalid-separator": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z--2023-02-01T00:00:00Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid date-time at character 21, expected end of string:\n\n"+
+						"    2023-01-01T00:00:00Z--2023-02-01T00:00:00Z\n"+
+						"                        ^",
+				),
+			},
+		},
+		"string-value-invalid-separator-missing": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid interval at character 21, expected \"/\":\n\n"+
+						"    2023-01-01T00:00:00Z\n"+
+						"                        ^",
+				),
+			},
+		},
+		"string-value-invalid-start": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01 00:00:00Z/P1D"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid date-time at character 11, expected \"T\":\n\n"+
+						"    2023-01-01 00:00:00Z/P1D\n"+
+						"              ^",
+				),
+			},
+		},
+		"string-value-invalid-zero-duration": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z/PT0S"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Error: Start 2023-01-01T00:00:00Z is not before end 2023-01-01T00:00:00Z.",
+				),
+			},
+		},
+		"string-value-valid-duration-end": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1M/2023-02-01T00:00:00Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-offsets": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00+05:30/2023-01-01T00:00:00-08:00"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-start-duration": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z/P1M"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-start-end": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-01-01T00:00:00Z/2023-02-01T00:00:00Z"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.ISO8601IntervalType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.ISO8601IntervalType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.ISO8601IntervalNull(),
+		},
+		"unknown": {
+			typ:         timetypes.ISO8601IntervalType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.ISO8601IntervalUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.ISO8601IntervalType{},
+			stringValue: types.StringValue("2023-01-01T00:00:00Z"),
+			expected:    timetypes.ISO8601IntervalUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid ISO 8601 Interval String Value",
+					"An unexpected error occurred while converting a string value that was expected to be ISO 8601 interval format. "+
+						"The ISO 8601 interval string format is an RFC 3339 start and end separated by a slash, or either one with an ISO 8601 duration, "+
+						"such as 2023-01-01T00:00:00Z/2023-02-01T00:00:00Z, 2023-01-01T00:00:00Z/P1M, or P1M/2023-02-01T00:00:00Z. "+
+						"The start must be before the end.\n\n"+
+						"Invalid interval at character 21, expected \"/\":\n\n"+
+						"    2023-01-01T00:00:00Z\n"+
+						"                        ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.ISO8601IntervalType{},
+			stringValue: types.StringValue("2023-01-01T00:00:00Z/P1M"),
+			expected:    testValue(t, timetypes.ISO8601IntervalString, "2023-01-01T00:00:00Z/P1M"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.ISO8601IntervalType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.ISO8601IntervalUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.ISO8601IntervalNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.ISO8601IntervalUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-interval-format"),
+			expected:       timetypes.ISO8601IntervalUnknown(),
+			expectedError:  fmt.Errorf(`parsing "not-interval-format" as ISO 8601 interval: invalid date-fullyear at offset 0: expected 4 digits`),
+		},
+		"string-value-invalid-end-before-start": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2023-02-01T00:00:00Z/2023-01-01T00:00:00Z"),
+			expected:       timetypes.ISO8601IntervalUnknown(),
+			expectedError:  fmt.Errorf("Start 2023-02-01T00:00:00Z is not before end 2023-01-01T00:00:00Z."),
+		},
+		"string-value-valid": {
+			typ:            timetypes.ISO8601IntervalType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "P1DT12H/2023-01-02T12:00:00Z"),
+			expected:       testValue(t, timetypes.ISO8601IntervalString, "P1DT12H/2023-01-02T12:00:00Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestISO8601IntervalTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ISO8601IntervalType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.ISO8601IntervalType{},
+			expected: timetypes.ISO8601Interval{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
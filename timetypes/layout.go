@@ -0,0 +1,90 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = Layout{}
+	_ basetypes.StringValuable                   = Layout{}
+	_ basetypes.StringValuableWithSemanticEquals = Layout{}
+)
+
+// Layout implements the attr.Value interface for usage in logic. Create
+// values with the LayoutType NullValue, ParseValue, UnknownValue, and
+// ValueFromTime methods.
+type Layout struct {
+	timestamp
+
+	typ LayoutType
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a Layout type
+//   - Has an equal LayoutType
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented instants instead.
+func (v Layout) Equal(o attr.Value) bool {
+	otherValue, ok := o.(Layout)
+
+	if !ok {
+		return false
+	}
+
+	if !otherValue.typ.Equal(v.typ) {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// StringSemanticEquals returns true if the given Layout represents the same
+// instant in time, regardless of the string representation. The framework
+// calls this method to keep the prior value and prevent unexpected
+// differences.
+func (v Layout) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Layout)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.value.Equal(newValue.value), diags
+}
+
+// Time returns the time.Time of a Layout. Strings without a time zone are
+// interpreted in the LayoutType location.
+func (v Layout) Time() time.Time {
+	return v.value
+}
+
+// ToRFC3339 converts the Layout to an RFC3339 with only the necessary
+// fractional second digits or an error diagnostic if the year is outside 0000
+// to 9999. A null or unknown Layout returns a null or unknown RFC3339.
+func (v Layout) ToRFC3339() (RFC3339, diag.Diagnostics) {
+	if v.null {
+		return RFC3339Null(), nil
+	}
+
+	if v.unknown {
+		return RFC3339Unknown(), nil
+	}
+
+	return RFC3339Time(v.value)
+}
+
+// Type returns the LayoutType of the Layout.
+func (v Layout) Type(_ context.Context) attr.Type {
+	return v.typ
+}
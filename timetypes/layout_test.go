@@ -0,0 +1,491 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLayoutEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Layout
+		other    attr.Value
+		expected bool
+	}{
+		"not-timetypes.Layout": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			other:    types.StringValue("2006-01-02 15:04:05"),
+			expected: false,
+		},
+		"null-null": {
+			value:    legacyLayoutType.NullValue(),
+			other:    legacyLayoutType.NullValue(),
+			expected: true,
+		},
+		"null-null-different-type": {
+			value:    legacyLayoutType.NullValue(),
+			other:    rfc822LayoutType.NullValue(),
+			expected: false,
+		},
+		"null-unknown": {
+			value:    legacyLayoutType.NullValue(),
+			other:    legacyLayoutType.UnknownValue(),
+			expected: false,
+		},
+		"null-value": {
+			value:    legacyLayoutType.NullValue(),
+			other:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			other:    legacyLayoutType.UnknownValue(),
+			expected: true,
+		},
+		"value-value-different": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			other:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:06"),
+			expected: false,
+		},
+		"value-value-different-type": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			other:    testValue(t, timetypes.NewLayoutType("Other Timestamp", "2006-01-02 15:04:05").ParseValue, "2006-01-02 15:04:05"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			other:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Layout
+		expected bool
+	}{
+		"null": {
+			value:    legacyLayoutType.NullValue(),
+			expected: true,
+		},
+		"unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Layout
+		expected bool
+	}{
+		"null": {
+			value:    legacyLayoutType.NullValue(),
+			expected: false,
+		},
+		"unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.Layout
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.Layout": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			newValue: types.StringValue("2006-01-02 15:04:05"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.Layout\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			newValue: testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:06"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			newValue: testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: true,
+		},
+		"value-value-zone-abbreviation": {
+			value:    testValue(t, rfc822LayoutType.ParseValue, "02 Jan 06 15:04 UTC"),
+			newValue: testValue(t, rfc822LayoutType.ParseValue, "02 Jan 06 15:04 GMT"),
+			expected: true,
+		},
+		"value-value-zone-abbreviation-different": {
+			value:    testValue(t, timetypes.NewLayoutType("Pacific Timestamp", time.RFC822, timetypes.WithLayoutLocation(time.FixedZone("PST", -8*60*60))).ParseValue, "02 Jan 06 15:04 PST"),
+			newValue: testValue(t, timetypes.NewLayoutType("Eastern Timestamp", time.RFC822, timetypes.WithLayoutLocation(time.FixedZone("EST", -5*60*60))).ParseValue, "02 Jan 06 15:04 EST"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Layout
+		expected string
+	}{
+		"null": {
+			value:    legacyLayoutType.NullValue(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: "\"2006-01-02 15:04:05\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Layout
+		expected time.Time
+	}{
+		"null": {
+			value:    legacyLayoutType.NullValue(),
+			expected: time.Time{},
+		},
+		"unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			expected: time.Time{},
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"value-location": {
+			value:    testValue(t, timetypes.NewLayoutType("Pacific Timestamp", "2006-01-02 15:04:05", timetypes.WithLayoutLocation(time.FixedZone("PDT", -7*60*60))).ParseValue, "2006-01-02 08:04:05"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Time()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestLayoutToRFC3339(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.Layout
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    legacyLayoutType.NullValue(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+		},
+		"value-location": {
+			value:    testValue(t, timetypes.NewLayoutType("Pacific Timestamp", "2006-01-02 15:04:05", timetypes.WithLayoutLocation(time.FixedZone("PDT", -7*60*60))).ParseValue, "2006-01-02 08:04:05"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T08:04:05-07:00"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToRFC3339()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.Layout
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    legacyLayoutType.NullValue(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: types.StringValue("2006-01-02 15:04:05"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Layout
+		expected tftypes.Value
+	}{
+		"null": {
+			value:    legacyLayoutType.NullValue(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02 15:04:05"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Layout
+		expected attr.Type
+	}{
+		"null": {
+			value:    rfc822LayoutType.NullValue(),
+			expected: rfc822LayoutType,
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: legacyLayoutType,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestLayoutValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.Layout
+		expected string
+	}{
+		"null": {
+			value:    legacyLayoutType.NullValue(),
+			expected: "",
+		},
+		"unknown": {
+			value:    legacyLayoutType.UnknownValue(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+			expected: "2006-01-02 15:04:05",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = LayoutType{}
+	_ attr.Type                    = LayoutType{}
+	_ basetypes.StringTypable      = LayoutType{}
+	_ xattr.TypeWithValidate       = LayoutType{}
+
+	_ timestampFormat = LayoutType{}
+)
+
+// LayoutTypeOption configures a LayoutType created with NewLayoutType.
+type LayoutTypeOption func(*LayoutType)
+
+// WithLayoutLocation sets the location used to interpret strings without a
+// time zone and to format values created with LayoutType.ValueFromTime.
+// Time zone abbreviations other than UTC and GMT are only accepted if the
+// location uses them. Defaults to time.UTC.
+func WithLayoutLocation(loc *time.Location) LayoutTypeOption {
+	return func(t *LayoutType) {
+		t.location = loc
+	}
+}
+
+// NewLayoutType returns a LayoutType for strings in the given Go time
+// package layout, such as "2006-01-02 15:04:05". The name is a human readable
+// name of the format, such as "Legacy API Timestamp", which is used in
+// diagnostics. Values of the type are Layout.
+func NewLayoutType(name string, layout string, opts ...LayoutTypeOption) LayoutType {
+	t := LayoutType{
+		formatName: name,
+		layout:     layout,
+		location:   time.UTC,
+	}
+
+	for _, opt := range opts {
+		opt(&t)
+	}
+
+	return t
+}
+
+// LayoutType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are strings in a Go time package
+// layout, which are parsed with time.ParseInLocation. Use NewLayoutType to
+// create a LayoutType, since the zero value only accepts empty strings.
+type LayoutType struct {
+	formatName string
+	layout     string
+	location   *time.Location
+}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t LayoutType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is LayoutType with the same name,
+// layout, and location.
+func (t LayoutType) Equal(o attr.Type) bool {
+	other, ok := o.(LayoutType)
+
+	if !ok {
+		return false
+	}
+
+	return t.formatName == other.formatName && t.layout == other.layout && t.Location().String() == other.Location().String()
+}
+
+// Layout returns the Go time package layout of the type.
+func (t LayoutType) Layout() string {
+	return t.layout
+}
+
+// Location returns the location used to interpret strings without a time
+// zone.
+func (t LayoutType) Location() *time.Location {
+	if t.location == nil {
+		return time.UTC
+	}
+
+	return t.location
+}
+
+// Name returns the human readable name of the type format.
+func (t LayoutType) Name() string {
+	return t.formatName
+}
+
+// NullValue returns a null Layout of the type.
+func (t LayoutType) NullValue() Layout {
+	return Layout{
+		timestamp: timestamp{
+			stringValue: stringValue{
+				null: true,
+			},
+		},
+		typ: t,
+	}
+}
+
+// ParseValue returns a known Layout of the type or any errors while
+// attempting to parse the string in the layout.
+func (t LayoutType) ParseValue(s string, schemaPath path.Path) (Layout, diag.Diagnostics) {
+	value, diags := timestampString(t, s, schemaPath)
+
+	return Layout{
+		timestamp: value,
+		typ:       t,
+	}, diags
+}
+
+// String returns a human readable string of the type, including the name.
+func (t LayoutType) String() string {
+	return "timetypes.LayoutType[" + t.formatName + "]"
+}
+
+// TerraformType always returns tftypes.String.
+func (t LayoutType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// UnknownValue returns an unknown Layout of the type.
+func (t LayoutType) UnknownValue() Layout {
+	return Layout{
+		timestamp: timestamp{
+			stringValue: stringValue{
+				unknown: true,
+			},
+		},
+		typ: t,
+	}
+}
+
+// Validate ensures the value is always conformant to the layout.
+func (t LayoutType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return timestampValidate(t, terraformValue, schemaPath)
+}
+
+// ValueFromString converts the types.String into a value.
+func (t LayoutType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value, diags := timestampValueFromString(t, in)
+
+	return Layout{
+		timestamp: value,
+		typ:       t,
+	}, diags
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t LayoutType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	value, err := timestampValueFromTerraform(t, terraformValue)
+
+	return Layout{
+		timestamp: value,
+		typ:       t,
+	}, err
+}
+
+// ValueFromTime returns a known Layout of the type with the given time
+// converted to the type location and formatted in the layout. Any
+// information the layout does not include, such as fractional seconds, is
+// not preserved.
+func (t LayoutType) ValueFromTime(value time.Time) Layout {
+	return Layout{
+		timestamp: timestampTime(t, value),
+		typ:       t,
+	}
+}
+
+// ValueType returns the associated attr.Value.
+func (t LayoutType) ValueType(_ context.Context) attr.Value {
+	return Layout{
+		typ: t,
+	}
+}
+
+// describe returns the layout format description for diagnostics.
+func (t LayoutType) describe() string {
+	return "The " + t.formatName + " string format is " + t.layout + ", such as " + t.example() + "."
+}
+
+// example returns 2006-01-02T15:04:05Z formatted in the layout, for
+// diagnostics.
+func (t LayoutType) example() string {
+	return time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).In(t.Location()).Format(t.layout)
+}
+
+// format returns the time converted to the type location and formatted in
+// the layout.
+func (t LayoutType) format(value time.Time) (time.Time, string) {
+	valueString := value.In(t.Location()).Format(t.layout)
+
+	// Parse the formatted string, so the time matches what Terraform
+	// receives. Errors keep the given time, since formatting and parsing are
+	// not symmetrical for every layout.
+	parsed, err := t.parse(valueString)
+
+	if err != nil {
+		return value, valueString
+	}
+
+	return parsed, valueString
+}
+
+// name returns the human readable name of the type format.
+func (t LayoutType) name() string {
+	return t.formatName
+}
+
+// parse parses the string in the layout with time.ParseInLocation. Time zone
+// abbreviations which are not UTC, GMT, or used by the type location are
+// rejected, since time.ParseInLocation gives them a fabricated zero offset.
+func (t LayoutType) parse(s string) (time.Time, error) {
+	value, err := time.ParseInLocation(t.layout, s, t.Location())
+
+	if err != nil {
+		return value, err
+	}
+
+	zoneName, _ := value.Zone()
+
+	if zoneName == "" || zoneName == "UTC" || strings.HasPrefix(zoneName, "GMT") || value.Location() == t.Location() {
+		return value, nil
+	}
+
+	return time.Time{}, fmt.Errorf(
+		"unknown offset of time zone abbreviation %q in location %s. "+
+			"Provider developers can configure a location which uses the abbreviation with WithLayoutLocation or use a layout with a numeric offset, such as -0700",
+		zoneName, t.Location(),
+	)
+}
@@ -0,0 +1,520 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// legacyLayoutType is a LayoutType for testing strings without a time zone.
+var legacyLayoutType = timetypes.NewLayoutType("Legacy Timestamp", "2006-01-02 15:04:05")
+
+// rfc822LayoutType is a LayoutType for testing strings with a time zone
+// abbreviation.
+var rfc822LayoutType = timetypes.NewLayoutType("RFC 822", time.RFC822)
+
+func TestLayoutTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.LayoutType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           legacyLayoutType,
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.LayoutType[Legacy Timestamp]"),
+		},
+		"ElementKeyInt": {
+			typ:           legacyLayoutType,
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.LayoutType[Legacy Timestamp]"),
+		},
+		"ElementKeyString": {
+			typ:           legacyLayoutType,
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.LayoutType[Legacy Timestamp]"),
+		},
+		"ElementKeyValue": {
+			typ:           legacyLayoutType,
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.LayoutType[Legacy Timestamp]"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.LayoutType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      legacyLayoutType,
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.LayoutType": {
+			typ:      legacyLayoutType,
+			other:    timetypes.NewLayoutType("Legacy Timestamp", "2006-01-02 15:04:05"),
+			expected: true,
+		},
+		"timetypes.LayoutType-different-layout": {
+			typ:      legacyLayoutType,
+			other:    timetypes.NewLayoutType("Legacy Timestamp", "2006-01-02 15:04"),
+			expected: false,
+		},
+		"timetypes.LayoutType-different-location": {
+			typ:      legacyLayoutType,
+			other:    timetypes.NewLayoutType("Legacy Timestamp", "2006-01-02 15:04:05", timetypes.WithLayoutLocation(time.FixedZone("PDT", -7*60*60))),
+			expected: false,
+		},
+		"timetypes.LayoutType-different-name": {
+			typ:      legacyLayoutType,
+			other:    timetypes.NewLayoutType("Other Timestamp", "2006-01-02 15:04:05"),
+			expected: false,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      legacyLayoutType,
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      legacyLayoutType,
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.LayoutType
+		expected string
+	}{
+		"legacy": {
+			typ:      legacyLayoutType,
+			expected: "timetypes.LayoutType[Legacy Timestamp]",
+		},
+		"zero": {
+			typ:      timetypes.LayoutType{},
+			expected: "timetypes.LayoutType[]",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.LayoutType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      legacyLayoutType,
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.LayoutType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Legacy Timestamp Terraform Value",
+					"An unexpected error occurred while attempting to read a Legacy Timestamp string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Legacy Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Legacy Timestamp format. "+
+						"The Legacy Timestamp string format is 2006-01-02 15:04:05, such as 2006-01-02 15:04:05.\n\n"+
+						"Error: parsing time \"2006-01-02T15:04:05Z\" as \"2006-01-02 15:04:05\": cannot parse \"T15:04:05Z\" as \" \"",
+				),
+			},
+		},
+		"string-value-invalid-location-example": {
+			typ:            timetypes.NewLayoutType("Pacific Timestamp", "02 Jan 06 15:04 MST", timetypes.WithLayoutLocation(time.FixedZone("PDT", -7*60*60))),
+			terraformValue: tftypes.NewValue(tftypes.String, "not-time-format"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Pacific Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Pacific Timestamp format. "+
+						"The Pacific Timestamp string format is 02 Jan 06 15:04 MST, such as 02 Jan 06 08:04 PDT.\n\n"+
+						"Error: parsing time \"not-time-format\" as \"02 Jan 06 15:04 MST\": cannot parse \"not-time-format\" as \"02\"",
+				),
+			},
+		},
+		"string-value-valid": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02 15:04:05"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-zone-abbreviation": {
+			typ:            timetypes.NewLayoutType("Mountain Timestamp", time.RFC822, timetypes.WithLayoutLocation(time.FixedZone("MST", -7*60*60))),
+			terraformValue: tftypes.NewValue(tftypes.String, "02 Jan 06 15:04 MST"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-zone-abbreviation-gmt": {
+			typ:            rfc822LayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, "02 Jan 06 15:04 GMT"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-zone-abbreviation-utc": {
+			typ:            rfc822LayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, "02 Jan 06 15:04 UTC"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-zone-abbreviation-unknown": {
+			typ:            rfc822LayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, "02 Jan 06 15:04 MST"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 822 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 822 format. "+
+						"The RFC 822 string format is 02 Jan 06 15:04 MST, such as 02 Jan 06 15:04 UTC.\n\n"+
+						"Error: unknown offset of time zone abbreviation \"MST\" in location UTC. "+
+						"Provider developers can configure a location which uses the abbreviation with WithLayoutLocation or use a layout with a numeric offset, such as -0700",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.LayoutType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         legacyLayoutType,
+			stringValue: types.StringNull(),
+			expected:    legacyLayoutType.NullValue(),
+		},
+		"unknown": {
+			typ:         legacyLayoutType,
+			stringValue: types.StringUnknown(),
+			expected:    legacyLayoutType.UnknownValue(),
+		},
+		"value-invalid": {
+			typ:         legacyLayoutType,
+			stringValue: types.StringValue("not-time-format"),
+			expected:    legacyLayoutType.UnknownValue(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Legacy Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Legacy Timestamp format. "+
+						"The Legacy Timestamp string format is 2006-01-02 15:04:05, such as 2006-01-02 15:04:05.\n\n"+
+						"Error: parsing time \"not-time-format\" as \"2006-01-02 15:04:05\": cannot parse \"not-time-format\" as \"2006\"",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         legacyLayoutType,
+			stringValue: types.StringValue("2006-01-02 15:04:05"),
+			expected:    testValue(t, legacyLayoutType.ParseValue, "2006-01-02 15:04:05"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.LayoutType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       legacyLayoutType.UnknownValue(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       legacyLayoutType.NullValue(),
+		},
+		"string-unknown": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       legacyLayoutType.UnknownValue(),
+		},
+		"string-value-invalid": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, "not-time-format"),
+			expected:       legacyLayoutType.UnknownValue(),
+			expectedError:  fmt.Errorf(`parsing time "not-time-format" as "2006-01-02 15:04:05": cannot parse "not-time-format" as "2006"`),
+		},
+		"string-value-valid": {
+			typ:            legacyLayoutType,
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02 15:04:05"),
+			expected:       legacyLayoutType.ValueFromTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutTypeValueFromTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ                 timetypes.LayoutType
+		value               time.Time
+		expectedValueString string
+		expectedTime        time.Time
+	}{
+		"location": {
+			typ:                 timetypes.NewLayoutType("Pacific Timestamp", "2006-01-02 15:04:05", timetypes.WithLayoutLocation(time.FixedZone("PDT", -7*60*60))),
+			value:               time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			expectedValueString: "2006-01-02 08:04:05",
+			expectedTime:        time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"truncated": {
+			typ:                 legacyLayoutType,
+			value:               time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expectedValueString: "2006-01-02 15:04:05",
+			expectedTime:        time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"utc": {
+			typ:                 legacyLayoutType,
+			value:               time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60)),
+			expectedValueString: "2006-01-02 08:04:05",
+			expectedTime:        time.Date(2006, 1, 2, 8, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueFromTime(testCase.value)
+
+			if diff := cmp.Diff(got.ValueString(), testCase.expectedValueString); diff != "" {
+				t.Errorf("unexpected string difference: %s", diff)
+			}
+
+			if !got.Time().Equal(testCase.expectedTime) {
+				t.Errorf("expected time %s, got: %s", testCase.expectedTime, got.Time())
+			}
+		})
+	}
+}
+
+func TestLayoutTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.LayoutType
+		expected attr.Type
+	}{
+		"legacy": {
+			typ:      legacyLayoutType,
+			expected: legacyLayoutType,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got.Type(context.Background()), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package timetypes
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = LocalDateTime{}
+	_ basetypes.StringValuable                   = LocalDateTime{}
+	_ basetypes.StringValuableWithSemanticEquals = LocalDateTime{}
+)
+
+// localDateTimeLayout is the time.Time layout of local date-time strings
+// created from a time.Time.
+const localDateTimeLayout = "2006-01-02T15:04:05.999999999"
+
+// LocalDateTimeNull returns a null LocalDateTime.
+func LocalDateTimeNull() LocalDateTime {
+	return LocalDateTime{
+		timestamp{
+			stringValue: stringValue{
+				null: true,
+			},
+		},
+	}
+}
+
+// LocalDateTimeString returns a known LocalDateTime or any errors while
+// attempting to parse the string as local date-time format.
+func LocalDateTimeString(s string, schemaPath path.Path) (LocalDateTime, diag.Diagnostics) {
+	value, diags := timestampString(LocalDateTimeType{}, s, schemaPath)
+
+	return LocalDateTime{value}, diags
+}
+
+// LocalDateTimeTime returns a known LocalDateTime with the wall clock date
+// and time of the given time in its location. The string representation
+// includes only the necessary fractional second digits. An error diagnostic
+// is returned if the year is outside 0000 to 9999.
+func LocalDateTimeTime(t time.Time) (LocalDateTime, diag.Diagnostics) {
+	if year := t.Year(); year < 0 || year > 9999 {
+		return LocalDateTimeUnknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Local Date-Time Conversion Error",
+				"An unexpected error occurred while converting a time to a Local Date-Time. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Time "+t.Format(time.RFC3339Nano)+" is outside the years 0000 to 9999, which Local Date-Time cannot represent.",
+			),
+		}
+	}
+
+	return LocalDateTime{timestampTime(LocalDateTimeType{}, t)}, nil
+}
+
+// LocalDateTimeUnknown returns an unknown LocalDateTime.
+func LocalDateTimeUnknown() LocalDateTime {
+	return LocalDateTime{
+		timestamp{
+			stringValue: stringValue{
+				unknown: true,
+			},
+		},
+	}
+}
+
+// LocalDateTime implements the attr.Value interface for usage in logic. It
+// represents a calendar date and wall clock time without any offset or time
+// zone, such as 2006-01-02T15:04:05. Use TimeIn to resolve it to an instant
+// in time in a location.
+type LocalDateTime struct {
+	timestamp
+}
+
+// Date returns the calendar date of a LocalDateTime. A null or unknown
+// LocalDateTime returns a null or unknown Date.
+func (v LocalDateTime) Date() Date {
+	if v.null {
+		return DateNull()
+	}
+
+	if v.unknown {
+		return DateUnknown()
+	}
+
+	year, month, day := v.value.Date()
+
+	return Date{
+		year:  year,
+		month: month,
+		day:   day,
+	}
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a LocalDateTime type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented date and times
+// instead.
+func (v LocalDateTime) Equal(o attr.Value) bool {
+	otherValue, ok := o.(LocalDateTime)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// StringSemanticEquals returns true if the given LocalDateTime represents
+// the same date and wall clock time, regardless of the fractional second
+// digits in the string representation. The framework calls this method to
+// keep the prior value and prevent unexpected differences.
+func (v LocalDateTime) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(LocalDateTime)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.value.Equal(newValue.value), diags
+}
+
+// Time returns the wall clock date and time of the LocalDateTime as a
+// time.Time in UTC, which is not the instant in time the LocalDateTime
+// represents in any location. Use TimeIn to resolve it in a location.
+func (v LocalDateTime) Time() time.Time {
+	return v.value
+}
+
+// TimeIn returns the time.Time of the LocalDateTime in the given location,
+// such as from TimeZone.Location.
+//
+// An error diagnostic is returned if the wall clock time does not exist in
+// the location, such as when clocks skip forward at the start of daylight
+// saving time. A warning diagnostic is returned if the wall clock time
+// occurs twice in the location, such as when clocks fall back at the end of
+// daylight saving time, and the earlier time is returned. A zero time.Time
+// is returned for a null or unknown LocalDateTime.
+func (v LocalDateTime) TimeIn(loc *time.Location) (time.Time, diag.Diagnostics) {
+	if v.null || v.unknown {
+		return time.Time{}, nil
+	}
+
+	if loc == nil {
+		return time.Time{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Local Date-Time Resolution Error",
+				"An unexpected error occurred while resolving a local date-time in a time zone. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Location is missing, such as from a null or unknown time zone.",
+			),
+		}
+	}
+
+	instants := localDateTimeInstants(v.value, loc)
+
+	switch len(instants) {
+	case 0:
+		return time.Time{}, diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Nonexistent Local Date-Time",
+				"The local date-time "+v.valueString+" does not exist in the "+loc.String()+" time zone, "+
+					"since clocks skip over it when the offset changes, such as at the start of daylight saving time. "+
+					"Use a local date-time outside of the skipped period.",
+			),
+		}
+	case 1:
+		return instants[0], nil
+	default:
+		return instants[0], diag.Diagnostics{
+			diag.NewWarningDiagnostic(
+				"Ambiguous Local Date-Time",
+				"The local date-time "+v.valueString+" occurs twice in the "+loc.String()+" time zone, "+
+					"since clocks repeat it when the offset changes, such as at the end of daylight saving time: "+
+					instants[0].Format(time.RFC3339Nano)+" and "+instants[1].Format(time.RFC3339Nano)+". "+
+					"The earlier time, "+instants[0].Format(time.RFC3339Nano)+", is used.",
+			),
+		}
+	}
+}
+
+// TimeOfDay returns the wall clock time of a LocalDateTime. A null or
+// unknown LocalDateTime returns a null or unknown TimeOfDay.
+func (v LocalDateTime) TimeOfDay() TimeOfDay {
+	if v.null {
+		return TimeOfDayNull()
+	}
+
+	if v.unknown {
+		return TimeOfDayUnknown()
+	}
+
+	return TimeOfDayTime(v.value)
+}
+
+// Type returns the attr.Type of LocalDateTime.
+func (v LocalDateTime) Type(_ context.Context) attr.Type {
+	return LocalDateTimeType{}
+}
+
+// localDateTimeInstants returns the instants in time, in ascending order,
+// where the location has the wall clock date and time, which is stored in
+// UTC. There are no instants during a gap where clocks skip forward and two
+// instants during an overlap where clocks fall back.
+func localDateTimeInstants(wall time.Time, loc *time.Location) []time.Time {
+	var instants []time.Time
+
+	// The offsets a day before and after cover both sides of any offset
+	// change affecting the wall clock time.
+	for _, probe := range []time.Time{wall.Add(-24 * time.Hour), wall, wall.Add(24 * time.Hour)} {
+		_, offset := probe.In(loc).Zone()
+		instant := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+
+		if !localDateTimeWall(instant).Equal(wall) {
+			continue
+		}
+
+		duplicate := false
+
+		for _, existing := range instants {
+			if existing.Equal(instant) {
+				duplicate = true
+
+				break
+			}
+		}
+
+		if !duplicate {
+			instants = append(instants, instant)
+		}
+	}
+
+	sort.Slice(instants, func(i, j int) bool {
+		return instants[i].Before(instants[j])
+	})
+
+	return instants
+}
+
+// localDateTimeWall returns the wall clock date and time of the time in its
+// location, which is stored in UTC.
+func localDateTimeWall(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, t.Nanosecond(), time.UTC)
+}
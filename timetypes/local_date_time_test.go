@@ -0,0 +1,717 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLocalDateTimeDate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.LocalDateTime
+		expected timetypes.Date
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: timetypes.DateNull(),
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			expected: timetypes.DateUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: testValue(t, timetypes.DateString, "2006-01-02"),
+		},
+		"value-end-of-day": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-12-31T23:59:59.999"),
+			expected: testValue(t, timetypes.DateString, "2006-12-31"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Date()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.LocalDateTime
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.LocalDateTimeNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.LocalDateTime": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			other:    types.StringValue("2006-01-02T15:04:05"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.LocalDateTimeNull(),
+			other:    timetypes.LocalDateTimeNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.LocalDateTimeNull(),
+			other:    timetypes.LocalDateTimeUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.LocalDateTimeNull(),
+			other:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			other:    timetypes.LocalDateTimeNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			other:    timetypes.LocalDateTimeUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			other:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			other:    timetypes.LocalDateTimeNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			other:    timetypes.LocalDateTimeUnknown(),
+			expected: false,
+		},
+		"value-value-different-string-same-date-time": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05.000"),
+			other:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			other:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:06"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			other:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.LocalDateTime
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.LocalDateTime
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.LocalDateTime
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.LocalDateTime": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			newValue: types.StringValue("2006-01-02T15:04:05"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.LocalDateTime\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			newValue: testValue(t, timetypes.LocalDateTimeString, "2006-01-03T15:04:05"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			newValue: testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: true,
+		},
+		"value-value-fractional-seconds": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05.500"),
+			newValue: testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05.5"),
+			expected: true,
+		},
+		"value-value-fractional-seconds-zero": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05.000"),
+			newValue: testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: true,
+		},
+		"value-value-lowercase-t": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02t15:04:05"),
+			newValue: testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.LocalDateTime
+		expected string
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: "\"2006-01-02T15:04:05\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time          time.Time
+		expected      timetypes.LocalDateTime
+		expectedDiags diag.Diagnostics
+	}{
+		"fractional-seconds": {
+			time:     time.Date(2006, time.January, 2, 15, 4, 5, 500000000, time.UTC),
+			expected: testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05.5"),
+		},
+		"offset": {
+			time:     time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("", -8*60*60)),
+			expected: testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+		},
+		"utc": {
+			time:     time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC),
+			expected: testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+		},
+		"year-0000": {
+			time:     time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: testValue(t, timetypes.LocalDateTimeString, "0000-01-01T00:00:00"),
+		},
+		"year-9999": {
+			time:     time.Date(9999, time.December, 31, 23, 59, 59, 0, time.FixedZone("", 8*60*60)),
+			expected: testValue(t, timetypes.LocalDateTimeString, "9999-12-31T23:59:59"),
+		},
+		"year-after-9999": {
+			time:     time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: timetypes.LocalDateTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Local Date-Time Conversion Error",
+					"An unexpected error occurred while converting a time to a Local Date-Time. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 10000-01-01T00:00:00Z is outside the years 0000 to 9999, which Local Date-Time cannot represent.",
+				),
+			},
+		},
+		"year-before-0000": {
+			time:     time.Date(-1, time.December, 31, 23, 59, 59, 0, time.UTC),
+			expected: timetypes.LocalDateTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Local Date-Time Conversion Error",
+					"An unexpected error occurred while converting a time to a Local Date-Time. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time -0001-12-31T23:59:59Z is outside the years 0000 to 9999, which Local Date-Time cannot represent.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.LocalDateTimeTime(testCase.time)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTimeIn(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.LocalDateTime
+		location      *time.Location
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			location: time.UTC,
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			location: time.UTC,
+		},
+		"value-location-nil": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2023-01-02T15:04:05"),
+			location: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Local Date-Time Resolution Error",
+					"An unexpected error occurred while resolving a local date-time in a time zone. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Location is missing, such as from a null or unknown time zone.",
+				),
+			},
+		},
+		"value-location-utc": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2023-01-02T15:04:05"),
+			location: time.UTC,
+			expected: "2023-01-02T15:04:05Z",
+		},
+		"value-location-zone": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2023-01-02T15:04:05"),
+			location: testValue(t, timetypes.TimeZoneString, "America/New_York").Location(),
+			expected: "2023-01-02T15:04:05-05:00",
+		},
+		"value-location-zone-daylight-saving-time": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2023-07-02T15:04:05.5"),
+			location: testValue(t, timetypes.TimeZoneString, "Europe/Paris").Location(),
+			expected: "2023-07-02T15:04:05.5+02:00",
+		},
+		"value-location-zone-gap": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2023-03-12T02:30:00"),
+			location: testValue(t, timetypes.TimeZoneString, "America/New_York").Location(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Nonexistent Local Date-Time",
+					"The local date-time 2023-03-12T02:30:00 does not exist in the America/New_York time zone, "+
+						"since clocks skip over it when the offset changes, such as at the start of daylight saving time. "+
+						"Use a local date-time outside of the skipped period.",
+				),
+			},
+		},
+		"value-location-zone-gap-day": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2011-12-30T12:00:00"),
+			location: testValue(t, timetypes.TimeZoneString, "Pacific/Apia").Location(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Nonexistent Local Date-Time",
+					"The local date-time 2011-12-30T12:00:00 does not exist in the Pacific/Apia time zone, "+
+						"since clocks skip over it when the offset changes, such as at the start of daylight saving time. "+
+						"Use a local date-time outside of the skipped period.",
+				),
+			},
+		},
+		"value-location-zone-gap-end": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2023-03-12T03:00:00"),
+			location: testValue(t, timetypes.TimeZoneString, "America/New_York").Location(),
+			expected: "2023-03-12T03:00:00-04:00",
+		},
+		"value-location-zone-overlap": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2023-11-05T01:30:00"),
+			location: testValue(t, timetypes.TimeZoneString, "America/New_York").Location(),
+			expected: "2023-11-05T01:30:00-04:00",
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Ambiguous Local Date-Time",
+					"The local date-time 2023-11-05T01:30:00 occurs twice in the America/New_York time zone, "+
+						"since clocks repeat it when the offset changes, such as at the end of daylight saving time: "+
+						"2023-11-05T01:30:00-04:00 and 2023-11-05T01:30:00-05:00. "+
+						"The earlier time, 2023-11-05T01:30:00-04:00, is used.",
+				),
+			},
+		},
+		"value-location-zone-overlap-end": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2023-11-05T02:00:00"),
+			location: testValue(t, timetypes.TimeZoneString, "America/New_York").Location(),
+			expected: "2023-11-05T02:00:00-05:00",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.TimeIn(testCase.location)
+
+			if testCase.expected == "" {
+				if !got.IsZero() {
+					t.Errorf("expected zero time, got: %s", got)
+				}
+			} else if diff := cmp.Diff(got.Format(time.RFC3339Nano), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.LocalDateTime
+		expected timetypes.TimeOfDay
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: timetypes.TimeOfDayNull(),
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			expected: timetypes.TimeOfDayUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+		},
+		"value-fractional-seconds": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05.500"),
+			expected: testValue(t, timetypes.TimeOfDayString, "15:04:05.5"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.TimeOfDay()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.LocalDateTime
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: types.StringValue("2006-01-02T15:04:05"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.LocalDateTime
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05"),
+		},
+		"value-fractional-seconds": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05.000"),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.000"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.LocalDateTime
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: timetypes.LocalDateTimeType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.LocalDateTime
+		expected string
+	}{
+		"null": {
+			value:    timetypes.LocalDateTimeNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.LocalDateTimeUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05.5"),
+			expected: "2006-01-02T15:04:05.5",
+		},
+		"value-lowercase-t": {
+			value:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02t15:04:05"),
+			expected: "2006-01-02t15:04:05",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = LocalDateTimeType{}
+	_ attr.Type                    = LocalDateTimeType{}
+	_ basetypes.StringTypable      = LocalDateTimeType{}
+	_ xattr.TypeWithValidate       = LocalDateTimeType{}
+
+	_ timestampFormatWithSuggestion = LocalDateTimeType{}
+)
+
+// LocalDateTimeType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are ISO 8601 local date-time strings
+// without an offset, such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999,
+// which are typically paired with a separate time zone attribute.
+type LocalDateTimeType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t LocalDateTimeType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is LocalDateTimeType.
+func (t LocalDateTimeType) Equal(o attr.Type) bool {
+	_, ok := o.(LocalDateTimeType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t LocalDateTimeType) String() string {
+	return "timetypes.LocalDateTimeType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t LocalDateTimeType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always local date-time conformant.
+func (t LocalDateTimeType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return timestampValidate(t, terraformValue, schemaPath)
+}
+
+// ValueFromString converts the types.String into a value.
+func (t LocalDateTimeType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value, diags := timestampValueFromString(t, in)
+
+	return LocalDateTime{value}, diags
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t LocalDateTimeType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	value, err := timestampValueFromTerraform(t, terraformValue)
+
+	return LocalDateTime{value}, err
+}
+
+// ValueType returns the associated attr.Value.
+func (t LocalDateTimeType) ValueType(_ context.Context) attr.Value {
+	return LocalDateTime{}
+}
+
+// describe returns the local date-time format description for diagnostics.
+func (t LocalDateTimeType) describe() string {
+	return "The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, " +
+		"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999."
+}
+
+// format returns the wall clock date and time of the time in its location,
+// which is stored in UTC, and its string representation with only the
+// necessary fractional second digits.
+func (t LocalDateTimeType) format(value time.Time) (time.Time, string) {
+	return localDateTimeWall(value), value.Format(localDateTimeLayout)
+}
+
+// name returns Local Date-Time.
+func (t LocalDateTimeType) name() string {
+	return "Local Date-Time"
+}
+
+// parse parses the string with parseLocalDateTime.
+func (t LocalDateTimeType) parse(s string) (time.Time, error) {
+	return parseLocalDateTime(s)
+}
+
+// suggestion returns the local date-time part of a common near-miss
+// timestamp string, such as one using a space instead of T, omitting
+// seconds, or including an offset.
+func (t LocalDateTimeType) suggestion(s string) string {
+	suggestion, _, ok := rfc3339NearMiss(s)
+
+	if !ok || suggestion == s {
+		return ""
+	}
+
+	if _, err := parseLocalDateTime(suggestion); err != nil {
+		return ""
+	}
+
+	return suggestion
+}
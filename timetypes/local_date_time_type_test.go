@@ -0,0 +1,546 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLocalDateTimeTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.LocalDateTimeType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.LocalDateTimeType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.LocalDateTimeType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.LocalDateTimeType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.LocalDateTimeType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.LocalDateTimeType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.LocalDateTimeType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.LocalDateTimeType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.LocalDateTimeType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.LocalDateTimeType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.LocalDateTimeType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.LocalDateTimeType": {
+			typ:      timetypes.LocalDateTimeType{},
+			other:    timetypes.LocalDateTimeType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.LocalDateTimeType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.LocalDateTimeType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.LocalDateTimeType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.LocalDateTimeType{},
+			expected: "timetypes.LocalDateTimeType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.LocalDateTimeType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.LocalDateTimeType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.LocalDateTimeType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time Terraform Value",
+					"An unexpected error occurred while attempting to read a Local Date-Time string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid-date": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-02-30T15:04:05"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid date-mday at character 9, expected 01-28:\n\n"+
+						"    2006-02-30T15:04:05\n"+
+						"            ^",
+				),
+			},
+		},
+		"string-value-invalid-empty": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, ""),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid date-fullyear at character 1, expected 4 digits:\n\n"+
+						"    \n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-leap-second": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2016-12-31T23:59:60"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid time-second at character 18, expected 00-59:\n\n"+
+						"    2016-12-31T23:59:60\n"+
+						"                     ^",
+				),
+			},
+		},
+		"string-value-invalid-leap-second-fractional": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2016-12-31T23:59:60.5"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid time-second at character 18, expected 00-59:\n\n"+
+						"    2016-12-31T23:59:60.5\n"+
+						"                     ^",
+				),
+			},
+		},
+		"string-value-invalid-missing-seconds": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid partial-time at character 17, expected \":\":\n\n"+
+						"    2006-01-02T15:04\n"+
+						"                    ^\n\n"+
+						"Did you mean 2006-01-02T15:04:00?",
+				),
+			},
+		},
+		"string-value-invalid-offset": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid local-date-time at character 20, expected \".\" or end of string:\n\n"+
+						"    2006-01-02T15:04:05Z\n"+
+						"                       ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05?",
+				),
+			},
+		},
+		"string-value-invalid-offset-numeric": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05-07:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid local-date-time at character 20, expected \".\" or end of string:\n\n"+
+						"    2006-01-02T15:04:05-07:00\n"+
+						"                       ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05?",
+				),
+			},
+		},
+		"string-value-invalid-space": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02 15:04:05"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid local-date-time at character 11, expected \"T\":\n\n"+
+						"    2006-01-02 15:04:05\n"+
+						"              ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05?",
+				),
+			},
+		},
+		"string-value-valid": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.999999999"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-lowercase-t": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02t15:04:05"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.LocalDateTimeType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.LocalDateTimeType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.LocalDateTimeNull(),
+		},
+		"unknown": {
+			typ:         timetypes.LocalDateTimeType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.LocalDateTimeUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.LocalDateTimeType{},
+			stringValue: types.StringValue("2006-01-02T15:04:05Z"),
+			expected:    timetypes.LocalDateTimeUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Local Date-Time String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Local Date-Time format. "+
+						"The local date-time string format is YYYY-MM-DDTHH:MM:SS with optional fractional seconds and without an offset, "+
+						"such as 2006-01-02T15:04:05 or 2006-01-02T15:04:05.999.\n\n"+
+						"Invalid local-date-time at character 20, expected \".\" or end of string:\n\n"+
+						"    2006-01-02T15:04:05Z\n"+
+						"                       ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05?",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.LocalDateTimeType{},
+			stringValue: types.StringValue("2006-01-02T15:04:05"),
+			expected:    testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.LocalDateTimeType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.LocalDateTimeUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.LocalDateTimeNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.LocalDateTimeUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			expected:       timetypes.LocalDateTimeUnknown(),
+			expectedError:  fmt.Errorf("parsing \"2006-01-02T15:04:05Z\" as local date-time: invalid local-date-time at offset 19: expected \".\" or end of string"),
+		},
+		"string-value-valid": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05"),
+			expected:       testValue(t, timetypes.LocalDateTimeString, "2006-01-02T15:04:05"),
+		},
+		"string-value-valid-lowercase-t": {
+			typ:            timetypes.LocalDateTimeType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02t15:04:05.5"),
+			expected:       testValue(t, timetypes.LocalDateTimeString, "2006-01-02t15:04:05.5"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalDateTimeTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.LocalDateTimeType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.LocalDateTimeType{},
+			expected: timetypes.LocalDateTime{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package timetypes
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseError describes a problem parsing a string in a timestamp format. Use
+// errors.As to inspect the details of errors returned by parsing functions,
+// such as ParseRFC3339.
+type ParseError struct {
+	// Format is the human readable name of the format being parsed, such as
+	// RFC 3339.
+	Format string
+
+	// Input is the entire string being parsed.
+	Input string
+
+	// Offset is the zero-based byte offset within Input where parsing
+	// failed.
+	Offset int
+
+	// Component is the name of the grammar component being parsed when the
+	// failure occurred, such as date-month or time-offset.
+	Component string
+
+	// Expected describes what was expected at Offset, such as 01-12 or "T".
+	Expected string
+}
+
+// Error returns a string representation of the ParseError.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parsing %q as %s: invalid %s at offset %d: expected %s", e.Input, e.Format, e.Component, e.Offset, e.Expected)
+}
+
+// detail returns a multiple line, practitioner friendly description of the
+// error which points at the invalid character, suitable for diagnostics.
+func (e *ParseError) detail() string {
+	// Terraform preserves lines which begin with whitespace, rather than
+	// wrapping them, so the pointer will line up with the input.
+	indent := "    "
+	column := utf8.RuneCountInString(e.Input[:e.Offset])
+
+	return fmt.Sprintf("Invalid %s at character %d, expected %s:\n\n", e.Component, column+1, e.Expected) +
+		indent + e.Input + "\n" +
+		indent + strings.Repeat(" ", column) + "^"
+}
+
+// parseErrorDetail returns the practitioner friendly description of a
+// *ParseError or the error message of any other error, suitable for
+// diagnostics.
+func parseErrorDetail(err error) string {
+	var parseErr *ParseError
+
+	if errors.As(err, &parseErr) {
+		return parseErr.detail()
+	}
+
+	return "Error: " + err.Error()
+}
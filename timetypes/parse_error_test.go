@@ -0,0 +1,50 @@
+package timetypes_test
+
+import (
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseErrorError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      *timetypes.ParseError
+		expected string
+	}{
+		"date-month": {
+			err: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-13-02T15:04:05Z",
+				Offset:    5,
+				Component: "date-month",
+				Expected:  "01-12",
+			},
+			expected: `parsing "2006-13-02T15:04:05Z" as RFC 3339: invalid date-month at offset 5: expected 01-12`,
+		},
+		"empty": {
+			err: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Component: "date-fullyear",
+				Expected:  "4 digits",
+			},
+			expected: `parsing "" as RFC 3339: invalid date-fullyear at offset 0: expected 4 digits`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.err.Error()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
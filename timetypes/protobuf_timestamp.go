@@ -0,0 +1,146 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = ProtobufTimestamp{}
+	_ basetypes.StringValuable                   = ProtobufTimestamp{}
+	_ basetypes.StringValuableWithSemanticEquals = ProtobufTimestamp{}
+)
+
+// ProtobufTimestampNull returns a null ProtobufTimestamp.
+func ProtobufTimestampNull() ProtobufTimestamp {
+	return ProtobufTimestamp{
+		timestamp{
+			stringValue: stringValue{
+				null: true,
+			},
+		},
+	}
+}
+
+// ProtobufTimestampRFC3339 returns a known ProtobufTimestamp converted from
+// the given RFC3339 or an error diagnostic if the year is outside 0001 to
+// 9999. A null or unknown RFC3339 returns a null or unknown
+// ProtobufTimestamp.
+func ProtobufTimestampRFC3339(value RFC3339) (ProtobufTimestamp, diag.Diagnostics) {
+	if value.null {
+		return ProtobufTimestampNull(), nil
+	}
+
+	if value.unknown {
+		return ProtobufTimestampUnknown(), nil
+	}
+
+	return ProtobufTimestampTime(value.value)
+}
+
+// ProtobufTimestampString returns a known ProtobufTimestamp or any errors
+// while attempting to parse the string as Protobuf Timestamp format.
+func ProtobufTimestampString(s string, schemaPath path.Path) (ProtobufTimestamp, diag.Diagnostics) {
+	value, diags := timestampString(ProtobufTimestampType{}, s, schemaPath)
+
+	return ProtobufTimestamp{value}, diags
+}
+
+// ProtobufTimestampTime returns a known ProtobufTimestamp with the given time
+// converted to UTC or an error diagnostic if the year is outside 0001 to
+// 9999. The string representation has 0, 3, 6, or 9 fractional second
+// digits, using the fewest digits which represent the time exactly.
+func ProtobufTimestampTime(t time.Time) (ProtobufTimestamp, diag.Diagnostics) {
+	if year := t.UTC().Year(); year < 1 || year > 9999 {
+		return ProtobufTimestampUnknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Protobuf Timestamp Conversion Error",
+				"An unexpected error occurred while converting a time to a Protobuf Timestamp. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Time "+t.Format(time.RFC3339Nano)+" is outside the years 0001 to 9999, which Protobuf Timestamp cannot represent.",
+			),
+		}
+	}
+
+	return ProtobufTimestamp{timestampTime(ProtobufTimestampType{}, t)}, nil
+}
+
+// ProtobufTimestampUnknown returns an unknown ProtobufTimestamp.
+func ProtobufTimestampUnknown() ProtobufTimestamp {
+	return ProtobufTimestamp{
+		timestamp{
+			stringValue: stringValue{
+				unknown: true,
+			},
+		},
+	}
+}
+
+// ProtobufTimestamp implements the attr.Value interface for usage in logic.
+// It represents the [Protocol Buffers JSON mapping] of
+// google.protobuf.Timestamp, such as 2006-01-02T15:04:05.000Z.
+//
+// [Protocol Buffers JSON mapping]: https://protobuf.dev/programming-guides/proto3/#json
+type ProtobufTimestamp struct {
+	timestamp
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a ProtobufTimestamp type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented instants instead.
+func (v ProtobufTimestamp) Equal(o attr.Value) bool {
+	otherValue, ok := o.(ProtobufTimestamp)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// StringSemanticEquals returns true if the given ProtobufTimestamp
+// represents the same instant in time, regardless of the fractional second
+// digits in the string representation, such as 2006-01-02T15:04:05Z and
+// 2006-01-02T15:04:05.000Z. The framework calls this method to keep the
+// prior value and prevent unexpected differences.
+func (v ProtobufTimestamp) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ProtobufTimestamp)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.value.Equal(newValue.value), diags
+}
+
+// ToRFC3339 converts the ProtobufTimestamp to an RFC3339 in UTC with only the
+// necessary fractional second digits. A null or unknown ProtobufTimestamp
+// returns a null or unknown RFC3339.
+func (v ProtobufTimestamp) ToRFC3339() (RFC3339, diag.Diagnostics) {
+	if v.null {
+		return RFC3339Null(), nil
+	}
+
+	if v.unknown {
+		return RFC3339Unknown(), nil
+	}
+
+	return RFC3339Time(v.value)
+}
+
+// Type returns the attr.Type of ProtobufTimestamp.
+func (v ProtobufTimestamp) Type(_ context.Context) attr.Type {
+	return ProtobufTimestampType{}
+}
@@ -0,0 +1,93 @@
+package timetypes
+
+import (
+	"time"
+)
+
+// ParseProtobufTimestamp parses a string using the [Protocol Buffers JSON
+// mapping] of google.protobuf.Timestamp, which is the RFC 3339 section 5.6
+// date-time grammar with the following restrictions:
+//
+//   - Years must be 0001 through 9999.
+//   - The T and Z characters must be uppercase.
+//   - The offset must be Z.
+//   - Fractional seconds, if present, must have 3, 6, or 9 digits.
+//   - Leap seconds (second 60) are not accepted.
+//
+// The returned time is in UTC. Any returned error is a *ParseError.
+//
+// [Protocol Buffers JSON mapping]: https://protobuf.dev/programming-guides/proto3/#json
+func ParseProtobufTimestamp(s string) (time.Time, error) {
+	p := &rfc3339Parser{
+		format: "Protobuf Timestamp",
+		input:  s,
+	}
+
+	year, month, day, err := p.fullDate()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if year == 0 {
+		p.offset = 0
+
+		return time.Time{}, p.errorf("date-fullyear", "0001-9999")
+	}
+
+	if err := p.char("date-time", "T", 'T'); err != nil {
+		return time.Time{}, err
+	}
+
+	// partial-time = time-hour ":" time-minute ":" time-second [time-secfrac]
+	secondOffset := p.offset + 6
+	hour, minute, second, nanosecond, err := p.partialTime()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if second == 60 {
+		p.offset = secondOffset
+
+		return time.Time{}, p.errorf("time-second", "00-59")
+	}
+
+	// The secfrac digits, if present, start after the second and "." and
+	// end at the current offset.
+	if secfracOffset := secondOffset + 3; p.offset > secfracOffset {
+		if n := p.offset - secfracOffset; n != 3 && n != 6 && n != 9 {
+			p.offset = secfracOffset
+
+			return time.Time{}, p.errorf("time-secfrac", "3, 6, or 9 digits")
+		}
+	}
+
+	if err := p.char("time-offset", "Z", 'Z'); err != nil {
+		return time.Time{}, err
+	}
+
+	if p.offset != len(p.input) {
+		return time.Time{}, p.errorf("date-time", "end of string")
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.UTC), nil
+}
+
+// formatProtobufTimestamp returns the time in UTC and its string
+// representation with 0, 3, 6, or 9 fractional second digits, using the
+// fewest digits which represent the time exactly.
+func formatProtobufTimestamp(t time.Time) (time.Time, string) {
+	t = t.UTC()
+
+	switch {
+	case t.Nanosecond() == 0:
+		return t, t.Format("2006-01-02T15:04:05Z")
+	case t.Nanosecond()%int(time.Millisecond) == 0:
+		return t, t.Format("2006-01-02T15:04:05.000Z")
+	case t.Nanosecond()%int(time.Microsecond) == 0:
+		return t, t.Format("2006-01-02T15:04:05.000000Z")
+	default:
+		return t, t.Format("2006-01-02T15:04:05.000000000Z")
+	}
+}
@@ -0,0 +1,172 @@
+package timetypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseProtobufTimestamp(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      time.Time
+		expectedError *timetypes.ParseError
+	}{
+		// Valid strings.
+		"valid-fractional-seconds-milliseconds": {
+			input:    "2006-01-02T15:04:05.123Z",
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC),
+		},
+		"valid-fractional-seconds-microseconds": {
+			input:    "2006-01-02T15:04:05.123456Z",
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC),
+		},
+		"valid-fractional-seconds-nanoseconds": {
+			input:    "2006-01-02T15:04:05.123456789Z",
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+		},
+		"valid-seconds": {
+			input:    "2006-01-02T15:04:05Z",
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"valid-year-0001": {
+			input:    "0001-01-01T00:00:00Z",
+			expected: time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"valid-year-9999": {
+			input:    "9999-12-31T23:59:59.999999999Z",
+			expected: time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		},
+
+		// Invalid strings.
+		"invalid-empty": {
+			input: "",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "",
+				Offset:    0,
+				Component: "date-fullyear",
+				Expected:  "4 digits",
+			},
+		},
+		"invalid-fractional-seconds-one-digit": {
+			input: "2006-01-02T15:04:05.1Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "2006-01-02T15:04:05.1Z",
+				Offset:    20,
+				Component: "time-secfrac",
+				Expected:  "3, 6, or 9 digits",
+			},
+		},
+		"invalid-fractional-seconds-ten-digits": {
+			input: "2006-01-02T15:04:05.1234567891Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "2006-01-02T15:04:05.1234567891Z",
+				Offset:    20,
+				Component: "time-secfrac",
+				Expected:  "3, 6, or 9 digits",
+			},
+		},
+		"invalid-leap-second": {
+			input: "1990-12-31T23:59:60Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "1990-12-31T23:59:60Z",
+				Offset:    17,
+				Component: "time-second",
+				Expected:  "00-59",
+			},
+		},
+		"invalid-lowercase-t": {
+			input: "2006-01-02t15:04:05Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "2006-01-02t15:04:05Z",
+				Offset:    10,
+				Component: "date-time",
+				Expected:  `"T"`,
+			},
+		},
+		"invalid-lowercase-z": {
+			input: "2006-01-02T15:04:05z",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "2006-01-02T15:04:05z",
+				Offset:    19,
+				Component: "time-offset",
+				Expected:  `"Z"`,
+			},
+		},
+		"invalid-offset": {
+			input: "2006-01-02T15:04:05+07:00",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "2006-01-02T15:04:05+07:00",
+				Offset:    19,
+				Component: "time-offset",
+				Expected:  `"Z"`,
+			},
+		},
+		"invalid-offset-zero": {
+			input: "2006-01-02T15:04:05+00:00",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "2006-01-02T15:04:05+00:00",
+				Offset:    19,
+				Component: "time-offset",
+				Expected:  `"Z"`,
+			},
+		},
+		"invalid-trailing-characters": {
+			input: "2006-01-02T15:04:05Z ",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "2006-01-02T15:04:05Z ",
+				Offset:    20,
+				Component: "date-time",
+				Expected:  "end of string",
+			},
+		},
+		"invalid-year-0000": {
+			input: "0000-01-01T00:00:00Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "Protobuf Timestamp",
+				Input:     "0000-01-01T00:00:00Z",
+				Offset:    0,
+				Component: "date-fullyear",
+				Expected:  "0001-9999",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := timetypes.ParseProtobufTimestamp(testCase.input)
+
+			var parseErr *timetypes.ParseError
+
+			if err != nil && !errors.As(err, &parseErr) {
+				t.Fatalf("expected *timetypes.ParseError, got: %T", err)
+			}
+
+			if diff := cmp.Diff(parseErr, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
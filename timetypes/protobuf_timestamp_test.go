@@ -0,0 +1,598 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProtobufTimestampEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ProtobufTimestamp
+		other    attr.Value
+		expected bool
+	}{
+		"not-timetypes.ProtobufTimestamp": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			other:    types.StringValue("2006-01-02T15:04:05Z"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			other:    timetypes.ProtobufTimestampNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.ProtobufTimestampNull(),
+			other:    timetypes.ProtobufTimestampUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.ProtobufTimestampNull(),
+			other:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			other:    timetypes.ProtobufTimestampUnknown(),
+			expected: true,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			other:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:06Z"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			other:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampFromRFC3339(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.RFC3339
+		expected      timetypes.ProtobufTimestamp
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.RFC3339Null(),
+			expected: timetypes.ProtobufTimestampNull(),
+		},
+		"unknown": {
+			value:    timetypes.RFC3339Unknown(),
+			expected: timetypes.ProtobufTimestampUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			expected: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+		},
+		"value-fractional-seconds": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.5Z"),
+			expected: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.500Z"),
+		},
+		"value-offset": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T22:04:05.1234+07:00"),
+			expected: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.123400Z"),
+		},
+		"value-year-0000": {
+			value:    testValue(t, timetypes.RFC3339String, "0000-12-31T23:59:59Z"),
+			expected: timetypes.ProtobufTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Protobuf Timestamp Conversion Error",
+					"An unexpected error occurred while converting a time to a Protobuf Timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 0000-12-31T23:59:59Z is outside the years 0001 to 9999, which Protobuf Timestamp cannot represent.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.ProtobufTimestampRFC3339(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampFromTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         time.Time
+		expected      timetypes.ProtobufTimestamp
+		expectedDiags diag.Diagnostics
+	}{
+		"microseconds": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC),
+			expected: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.123456Z"),
+		},
+		"milliseconds": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC),
+			expected: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.120Z"),
+		},
+		"nanoseconds": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 1, time.UTC),
+			expected: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.000000001Z"),
+		},
+		"offset": {
+			value:    time.Date(2006, 1, 2, 22, 4, 5, 0, time.FixedZone("", 7*60*60)),
+			expected: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+		},
+		"seconds": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			expected: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+		},
+		"year-10000": {
+			value:    time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: timetypes.ProtobufTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Protobuf Timestamp Conversion Error",
+					"An unexpected error occurred while converting a time to a Protobuf Timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 10000-01-01T00:00:00Z is outside the years 0001 to 9999, which Protobuf Timestamp cannot represent.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.ProtobufTimestampTime(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ProtobufTimestamp
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ProtobufTimestamp
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ProtobufTimestamp
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.ProtobufTimestamp": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			newValue: types.StringValue("2006-01-02T15:04:05Z"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.ProtobufTimestamp\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			newValue: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:06Z"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			newValue: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: true,
+		},
+		"value-value-fractional-seconds": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			newValue: testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.000Z"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ProtobufTimestamp
+		expected string
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: "\"2006-01-02T15:04:05Z\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ProtobufTimestamp
+		expected time.Time
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: time.Time{},
+		},
+		"unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			expected: time.Time{},
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"value-fractional-seconds": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.123456789Z"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Time()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampToRFC3339(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ProtobufTimestamp
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+		},
+		"value-fractional-seconds": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.500Z"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.5Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToRFC3339()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.ProtobufTimestamp
+		expected      types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: types.StringValue("2006-01-02T15:04:05Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ProtobufTimestamp
+		expected tftypes.Value
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ProtobufTimestamp
+		expected attr.Type
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: timetypes.ProtobufTimestampType{},
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: timetypes.ProtobufTimestampType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.ProtobufTimestamp
+		expected string
+	}{
+		"null": {
+			value:    timetypes.ProtobufTimestampNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.ProtobufTimestampUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+			expected: "2006-01-02T15:04:05Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
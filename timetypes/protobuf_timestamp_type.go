@@ -0,0 +1,124 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = ProtobufTimestampType{}
+	_ attr.Type                    = ProtobufTimestampType{}
+	_ basetypes.StringTypable      = ProtobufTimestampType{}
+	_ xattr.TypeWithValidate       = ProtobufTimestampType{}
+
+	_ timestampFormatWithSuggestion = ProtobufTimestampType{}
+)
+
+// ProtobufTimestampType implements the attr.Type interface for usage in
+// schema definitions and data models. Values are RFC 3339 strings in UTC
+// with the Z offset, 0, 3, 6, or 9 fractional second digits, and years 0001
+// through 9999, as required by the Protocol Buffers JSON mapping of
+// google.protobuf.Timestamp.
+type ProtobufTimestampType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t ProtobufTimestampType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is ProtobufTimestampType.
+func (t ProtobufTimestampType) Equal(o attr.Type) bool {
+	_, ok := o.(ProtobufTimestampType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t ProtobufTimestampType) String() string {
+	return "timetypes.ProtobufTimestampType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t ProtobufTimestampType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always Protobuf Timestamp conformant.
+func (t ProtobufTimestampType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	return timestampValidate(t, terraformValue, schemaPath)
+}
+
+// ValueFromString converts the types.String into a value.
+func (t ProtobufTimestampType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value, diags := timestampValueFromString(t, in)
+
+	return ProtobufTimestamp{value}, diags
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t ProtobufTimestampType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	value, err := timestampValueFromTerraform(t, terraformValue)
+
+	return ProtobufTimestamp{value}, err
+}
+
+// ValueType returns the associated attr.Value.
+func (t ProtobufTimestampType) ValueType(_ context.Context) attr.Value {
+	return ProtobufTimestamp{}
+}
+
+// describe returns the Protobuf Timestamp format description for
+// diagnostics.
+func (t ProtobufTimestampType) describe() string {
+	return "The Protobuf Timestamp string format is RFC 3339 in UTC, YYYY-MM-DDTHH:MM:SSZ, with 0, 3, 6, or 9 fractional second digits and years 0001 through 9999, " +
+		"such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05.000Z."
+}
+
+// format returns the time in UTC and its string representation with the
+// fewest necessary fractional second digits.
+func (t ProtobufTimestampType) format(value time.Time) (time.Time, string) {
+	return formatProtobufTimestamp(value)
+}
+
+// name returns Protobuf Timestamp.
+func (t ProtobufTimestampType) name() string {
+	return "Protobuf Timestamp"
+}
+
+// parse parses the string with ParseProtobufTimestamp.
+func (t ProtobufTimestampType) parse(s string) (time.Time, error) {
+	return ParseProtobufTimestamp(s)
+}
+
+// suggestion returns the canonical string of a valid RFC 3339 string, such
+// as one with an offset other than Z or an unsupported number of fractional
+// second digits.
+func (t ProtobufTimestampType) suggestion(s string) string {
+	value, err := ParseRFC3339(s)
+
+	if err != nil {
+		return ""
+	}
+
+	_, suggestion := formatProtobufTimestamp(value)
+
+	if suggestion == s {
+		return ""
+	}
+
+	if _, err := ParseProtobufTimestamp(suggestion); err != nil {
+		return ""
+	}
+
+	return suggestion
+}
@@ -0,0 +1,448 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProtobufTimestampTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.ProtobufTimestampType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.ProtobufTimestampType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.ProtobufTimestampType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.ProtobufTimestampType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.ProtobufTimestampType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.ProtobufTimestampType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.ProtobufTimestampType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.ProtobufTimestampType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.ProtobufTimestampType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ProtobufTimestampType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.ProtobufTimestampType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.ProtobufTimestampType": {
+			typ:      timetypes.ProtobufTimestampType{},
+			other:    timetypes.ProtobufTimestampType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.ProtobufTimestampType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.ProtobufTimestampType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ProtobufTimestampType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.ProtobufTimestampType{},
+			expected: "timetypes.ProtobufTimestampType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ProtobufTimestampType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.ProtobufTimestampType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.ProtobufTimestampType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Protobuf Timestamp Terraform Value",
+					"An unexpected error occurred while attempting to read a Protobuf Timestamp string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-fractional-seconds-one-digit": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.5Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Protobuf Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Protobuf Timestamp format. "+
+						"The Protobuf Timestamp string format is RFC 3339 in UTC, YYYY-MM-DDTHH:MM:SSZ, with 0, 3, 6, or 9 fractional second digits and years 0001 through 9999, "+
+						"such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05.000Z.\n\n"+
+						"Invalid time-secfrac at character 21, expected 3, 6, or 9 digits:\n\n"+
+						"    2006-01-02T15:04:05.5Z\n"+
+						"                        ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05.500Z?",
+				),
+			},
+		},
+		"string-value-invalid": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Protobuf Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Protobuf Timestamp format. "+
+						"The Protobuf Timestamp string format is RFC 3339 in UTC, YYYY-MM-DDTHH:MM:SSZ, with 0, 3, 6, or 9 fractional second digits and years 0001 through 9999, "+
+						"such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05.000Z.\n\n"+
+						"Invalid date-time at character 11, expected \"T\":\n\n"+
+						"    2006-01-02\n"+
+						"              ^",
+				),
+			},
+		},
+		"string-value-offset": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T22:04:05+07:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Protobuf Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Protobuf Timestamp format. "+
+						"The Protobuf Timestamp string format is RFC 3339 in UTC, YYYY-MM-DDTHH:MM:SSZ, with 0, 3, 6, or 9 fractional second digits and years 0001 through 9999, "+
+						"such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05.000Z.\n\n"+
+						"Invalid time-offset at character 20, expected \"Z\":\n\n"+
+						"    2006-01-02T22:04:05+07:00\n"+
+						"                       ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05Z?",
+				),
+			},
+		},
+		"string-value-valid": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123456Z"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.ProtobufTimestampType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.ProtobufTimestampType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.ProtobufTimestampNull(),
+		},
+		"unknown": {
+			typ:         timetypes.ProtobufTimestampType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.ProtobufTimestampUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.ProtobufTimestampType{},
+			stringValue: types.StringValue("0000-01-01T00:00:00Z"),
+			expected:    timetypes.ProtobufTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Protobuf Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be Protobuf Timestamp format. "+
+						"The Protobuf Timestamp string format is RFC 3339 in UTC, YYYY-MM-DDTHH:MM:SSZ, with 0, 3, 6, or 9 fractional second digits and years 0001 through 9999, "+
+						"such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05.000Z.\n\n"+
+						"Invalid date-fullyear at character 1, expected 0001-9999:\n\n"+
+						"    0000-01-01T00:00:00Z\n"+
+						"    ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.ProtobufTimestampType{},
+			stringValue: types.StringValue("2006-01-02T15:04:05Z"),
+			expected:    testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.ProtobufTimestampType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.ProtobufTimestampUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.ProtobufTimestampNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.ProtobufTimestampUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+00:00"),
+			expected:       timetypes.ProtobufTimestampUnknown(),
+			expectedError:  fmt.Errorf(`parsing "2006-01-02T15:04:05+00:00" as Protobuf Timestamp: invalid time-offset at offset 19: expected "Z"`),
+		},
+		"string-value-valid": {
+			typ:            timetypes.ProtobufTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123Z"),
+			expected:       testValue(t, timetypes.ProtobufTimestampString, "2006-01-02T15:04:05.123Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProtobufTimestampTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.ProtobufTimestampType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.ProtobufTimestampType{},
+			expected: timetypes.ProtobufTimestamp{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -7,69 +7,64 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure implementation satisfies expected interfaces.
 var (
-	_ attr.Value = RFC3339{}
+	_ attr.Value                                 = RFC3339{}
+	_ basetypes.StringValuable                   = RFC3339{}
+	_ basetypes.StringValuableWithSemanticEquals = RFC3339{}
 )
 
 // RFC3339Null returns a null RFC3339.
 func RFC3339Null() RFC3339 {
 	return RFC3339{
-		null: true,
+		timestamp{
+			stringValue: stringValue{
+				null: true,
+			},
+		},
 	}
 }
 
 // RFC3339String returns a known RFC3339 or any errors while attempting
 // to parse the string as RFC 3339 format.
 func RFC3339String(s string, schemaPath path.Path) (RFC3339, diag.Diagnostics) {
-	t, err := time.Parse(time.RFC3339, s)
+	value, diags := timestampString(RFC3339Type{}, s, schemaPath)
 
-	if err != nil {
-		return RFC3339{
-				unknown: true,
-			}, diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(
-					schemaPath,
-					"Invalid RFC 3339 String Value",
-					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
-						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
-						"Error: "+err.Error(),
-				),
-			}
-	}
-
-	return RFC3339{
-		value: t,
-	}, nil
+	return RFC3339{value}, diags
 }
 
-// RFC3339Time returns a known RFC3339 with the given time.
-func RFC3339Time(t time.Time) RFC3339 {
-	return RFC3339{
-		value: t,
-	}
+// RFC3339Time returns a known RFC3339 with the given time or an error
+// diagnostic if the year is outside 0000 to 9999. The string representation
+// includes only the necessary fractional second digits. Use
+// RFC3339Type.ValueFromTime to create a value with the type options instead.
+func RFC3339Time(t time.Time) (RFC3339, diag.Diagnostics) {
+	return RFC3339Type{}.ValueFromTime(t)
 }
 
 // RFC3339Unknown returns an unknown RFC3339.
 func RFC3339Unknown() RFC3339 {
 	return RFC3339{
-		unknown: true,
+		timestamp{
+			stringValue: stringValue{
+				unknown: true,
+			},
+		},
 	}
 }
 
 // RFC3339 implements the attr.Value interface for usage in logic.
 type RFC3339 struct {
-	null    bool
-	unknown bool
-	value   time.Time
+	timestamp
 }
 
 // Equal returns true if the given attr.Value matches the following:
 //   - Is a RFC3339 type
-//   - Has the same null, unknown, and value data
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented instants instead.
 func (v RFC3339) Equal(o attr.Value) bool {
 	otherValue, ok := o.(RFC3339)
 
@@ -77,56 +72,44 @@ func (v RFC3339) Equal(o attr.Value) bool {
 		return false
 	}
 
-	if otherValue.null != v.null {
-		return false
-	}
-
-	if otherValue.unknown != v.unknown {
-		return false
-	}
-
-	return otherValue.value.Equal(v.value)
-}
-
-// IsNull returns true if the RFC3339 represents a null Value.
-func (v RFC3339) IsNull() bool {
-	return v.null
-}
-
-// IsUnknown returns true if the RFC3339 represents an unknown Value.
-func (v RFC3339) IsUnknown() bool {
-	return v.unknown
+	return v.stringValue.equal(otherValue.stringValue)
 }
 
-// String returns a human readable string of the RFC3339.
-func (v RFC3339) String() string {
-	if v.null {
-		return attr.NullValueString
+// InTimeZone returns the RFC3339 rendered in the time zone, such as
+// 2006-01-02T10:04:05-05:00 for 2006-01-02T15:04:05Z in America/New_York. The
+// string representation includes only the necessary fractional second
+// digits. The RFC3339 is returned as-is if it is null or unknown or the
+// TimeZone is null, while an unknown RFC3339 is returned if the TimeZone is
+// unknown. An error diagnostic is returned if the year in the time zone is
+// outside 0000 to 9999.
+func (v RFC3339) InTimeZone(zone TimeZone) (RFC3339, diag.Diagnostics) {
+	if v.null || v.unknown || zone.null {
+		return v, nil
 	}
 
-	if v.unknown {
-		return attr.UnknownValueString
+	if zone.unknown {
+		return RFC3339Unknown(), nil
 	}
 
-	return `"` + v.value.Format(time.RFC3339) + `"`
+	return RFC3339Time(v.value.In(zone.value))
 }
 
-// Time returns the time.Time of a RFC3339.
-func (v RFC3339) Time() time.Time {
-	return v.value
-}
+// StringSemanticEquals returns true if the given RFC3339 represents the same
+// instant in time, regardless of the offset or fractional second digits in
+// the string representation. The framework calls this method to keep the
+// prior value and prevent unexpected differences.
+func (v RFC3339) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-// ToTerraformValue converts the RFC3339 to a tftypes.String.
-func (v RFC3339) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
-	if v.null {
-		return tftypes.NewValue(tftypes.String, nil), nil
-	}
+	newValue, ok := newValuable.(RFC3339)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
 
-	if v.unknown {
-		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+		return false, diags
 	}
 
-	return tftypes.NewValue(tftypes.String, v.value.Format(time.RFC3339)), nil
+	return v.value.Equal(newValue.value), diags
 }
 
 // Type returns the attr.Type of RFC3339.
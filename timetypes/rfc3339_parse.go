@@ -0,0 +1,439 @@
+package timetypes
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseRFC3339 parses a string using the [RFC 3339 section 5.6] date-time
+// grammar, including the [RFC 3339 section 5.7] restrictions on day of month
+// and leap seconds.
+//
+// Unlike time.Parse with the time.RFC3339 layout, the lowercase t and z
+// characters are accepted, the comma fractional second separator is
+// rejected, and leap seconds (second 60) are accepted when they occur at the
+// end of a UTC day which is the last day of a month. Since time.Time cannot
+// represent leap seconds, they are returned as the following second.
+// Fractional second digits beyond nanoseconds are truncated.
+//
+// Any returned error is a *ParseError.
+//
+// [RFC 3339 section 5.6]: https://www.rfc-editor.org/rfc/rfc3339#section-5.6
+// [RFC 3339 section 5.7]: https://www.rfc-editor.org/rfc/rfc3339#section-5.7
+func ParseRFC3339(s string) (time.Time, error) {
+	p := &rfc3339Parser{
+		format: "RFC 3339",
+		input:  s,
+	}
+
+	return p.dateTime()
+}
+
+// parseFullDate parses a string using the RFC 3339 section 5.6 full-date
+// grammar, returning the year, month, and day. Any returned error is a
+// *ParseError.
+func parseFullDate(s string) (int, time.Month, int, error) {
+	p := &rfc3339Parser{
+		format: "RFC 3339 full-date",
+		input:  s,
+	}
+
+	year, month, day, err := p.fullDate()
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if p.offset != len(p.input) {
+		return 0, 0, 0, p.errorf("full-date", "end of string")
+	}
+
+	return year, time.Month(month), day, nil
+}
+
+// parseLocalDateTime parses a string using the RFC 3339 section 5.6
+// date-time grammar without the time-offset, which is the ISO 8601 local
+// time representation, such as 2006-01-02T15:04:05. Leap seconds are not
+// accepted, since they cannot be verified without an offset. The wall clock
+// time is returned in UTC. Any returned error is a *ParseError.
+func parseLocalDateTime(s string) (time.Time, error) {
+	p := &rfc3339Parser{
+		format: "local date-time",
+		input:  s,
+	}
+
+	year, month, day, err := p.fullDate()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("local-date-time", "T", 'T', 't'); err != nil {
+		return time.Time{}, err
+	}
+
+	partialTimeOffset := p.offset
+	hour, minute, second, nanosecond, err := p.partialTime()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if second == 60 {
+		// Point at time-second, which follows "HH:MM:".
+		p.offset = partialTimeOffset + 6
+
+		return time.Time{}, p.errorf("time-second", "00-59")
+	}
+
+	if p.offset != len(p.input) {
+		return time.Time{}, p.errorf("local-date-time", `"." or end of string`)
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.UTC), nil
+}
+
+// parseTimeOfDay parses a string using the RFC 3339 section 5.6
+// partial-time grammar, except that the time-second component is optional and
+// leap seconds are not accepted, returning the hour, minute, second, and
+// nanosecond. Any returned error is a *ParseError.
+func parseTimeOfDay(s string) (int, int, int, int, error) {
+	p := &rfc3339Parser{
+		format: "RFC 3339 partial-time",
+		input:  s,
+	}
+
+	hour, minute, err := p.timeHourMinute()
+
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	if p.offset == len(p.input) {
+		return hour, minute, 0, 0, nil
+	}
+
+	if p.input[p.offset] != ':' {
+		return 0, 0, 0, 0, p.errorf("partial-time", `":" or end of string`)
+	}
+
+	p.offset++
+
+	secondOffset := p.offset
+	second, nanosecond, err := p.timeSecond()
+
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	if second == 60 {
+		p.offset = secondOffset
+
+		return 0, 0, 0, 0, p.errorf("time-second", "00-59")
+	}
+
+	if p.offset != len(p.input) {
+		return 0, 0, 0, 0, p.errorf("partial-time", `"." or end of string`)
+	}
+
+	return hour, minute, second, nanosecond, nil
+}
+
+// parseTimeOffset parses a string using the RFC 3339 section 5.6 time-offset
+// grammar, returning the offset in seconds east of UTC. Any returned error is
+// a *ParseError.
+func parseTimeOffset(s string) (int, error) {
+	p := &rfc3339Parser{
+		format: "RFC 3339 time-offset",
+		input:  s,
+	}
+
+	loc, err := p.timeOffset()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if p.offset != len(p.input) {
+		return 0, p.errorf("time-offset", "end of string")
+	}
+
+	_, offset := time.Unix(0, 0).In(loc).Zone()
+
+	return offset, nil
+}
+
+// rfc3339Parser is a recursive descent parser for the RFC 3339 date-time
+// grammar and its productions.
+type rfc3339Parser struct {
+	format string
+	input  string
+	offset int
+}
+
+// dateTime parses: date-time = full-date "T" full-time
+func (p *rfc3339Parser) dateTime() (time.Time, error) {
+	year, month, day, err := p.fullDate()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := p.char("date-time", "T", 'T', 't'); err != nil {
+		return time.Time{}, err
+	}
+
+	partialTimeOffset := p.offset
+	hour, minute, second, nanosecond, err := p.partialTime()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := p.timeOffset()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if p.offset != len(p.input) {
+		return time.Time{}, p.errorf("date-time", "end of string")
+	}
+
+	if second == 60 {
+		utc := time.Date(year, time.Month(month), day, hour, minute, 0, 0, loc).UTC()
+
+		if utc.Hour() != 23 || utc.Minute() != 59 || utc.AddDate(0, 0, 1).Day() != 1 {
+			// Point at time-second, which follows "HH:MM:".
+			p.offset = partialTimeOffset + 6
+
+			return time.Time{}, p.errorf("time-second", "00-59, 60 is only valid for a leap second at the end of a month in UTC")
+		}
+	}
+
+	return time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, loc), nil
+}
+
+// fullDate parses: full-date = date-fullyear "-" date-month "-" date-mday
+func (p *rfc3339Parser) fullDate() (int, int, int, error) {
+	year, err := p.digits("date-fullyear", 4, 0, 9999)
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if err := p.char("full-date", "-", '-'); err != nil {
+		return 0, 0, 0, err
+	}
+
+	month, err := p.digits("date-month", 2, 1, 12)
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if err := p.char("full-date", "-", '-'); err != nil {
+		return 0, 0, 0, err
+	}
+
+	day, err := p.digits("date-mday", 2, 1, daysIn(year, month))
+
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return year, month, day, nil
+}
+
+// partialTime parses:
+// partial-time = time-hour ":" time-minute ":" time-second [time-secfrac]
+func (p *rfc3339Parser) partialTime() (int, int, int, int, error) {
+	hour, minute, err := p.timeHourMinute()
+
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	if err := p.char("partial-time", ":", ':'); err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	second, nanosecond, err := p.timeSecond()
+
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	return hour, minute, second, nanosecond, nil
+}
+
+// secfrac parses the digits of: time-secfrac = "." 1*DIGIT
+func (p *rfc3339Parser) secfrac() (int, error) {
+	start := p.offset
+	nanosecond := 0
+
+	for p.offset < len(p.input) && isDigit(p.input[p.offset]) {
+		if p.offset-start < 9 {
+			nanosecond = nanosecond*10 + int(p.input[p.offset]-'0')
+		}
+
+		p.offset++
+	}
+
+	if p.offset == start {
+		return 0, p.errorf("time-secfrac", "one or more digits")
+	}
+
+	for i := p.offset - start; i < 9; i++ {
+		nanosecond *= 10
+	}
+
+	return nanosecond, nil
+}
+
+// timeHourMinute parses the start of partial-time: time-hour ":" time-minute
+func (p *rfc3339Parser) timeHourMinute() (int, int, error) {
+	hour, err := p.digits("time-hour", 2, 0, 23)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := p.char("partial-time", ":", ':'); err != nil {
+		return 0, 0, err
+	}
+
+	minute, err := p.digits("time-minute", 2, 0, 59)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return hour, minute, nil
+}
+
+// timeSecond parses the end of partial-time: time-second [time-secfrac]
+// The second may be 60, which callers must verify is a valid leap second.
+func (p *rfc3339Parser) timeSecond() (int, int, error) {
+	second, err := p.digits("time-second", 2, 0, 60)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if p.offset >= len(p.input) || p.input[p.offset] != '.' {
+		return second, 0, nil
+	}
+
+	p.offset++
+
+	nanosecond, err := p.secfrac()
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return second, nanosecond, nil
+}
+
+// timeOffset parses: time-offset = "Z" / time-numoffset
+func (p *rfc3339Parser) timeOffset() (*time.Location, error) {
+	if p.offset >= len(p.input) {
+		return nil, p.errorf("time-offset", `"Z" or "+" or "-"`)
+	}
+
+	var sign int
+
+	switch p.input[p.offset] {
+	case 'Z', 'z':
+		p.offset++
+
+		return time.UTC, nil
+	case '+':
+		sign = 1
+	case '-':
+		sign = -1
+	default:
+		return nil, p.errorf("time-offset", `"Z" or "+" or "-"`)
+	}
+
+	p.offset++
+
+	hour, err := p.digits("time-numoffset", 2, 0, 23)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.char("time-numoffset", ":", ':'); err != nil {
+		return nil, err
+	}
+
+	minute, err := p.digits("time-numoffset", 2, 0, 59)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return time.FixedZone("", sign*(hour*60*60+minute*60)), nil
+}
+
+// char consumes a single character matching any of the given characters.
+func (p *rfc3339Parser) char(component string, expected string, chars ...byte) error {
+	if p.offset < len(p.input) {
+		for _, c := range chars {
+			if p.input[p.offset] == c {
+				p.offset++
+
+				return nil
+			}
+		}
+	}
+
+	return p.errorf(component, `"`+expected+`"`)
+}
+
+// digits consumes exactly n digits and verifies the number is within the
+// given range.
+func (p *rfc3339Parser) digits(component string, n int, low int, high int) (int, error) {
+	start := p.offset
+	result := 0
+
+	for i := 0; i < n; i++ {
+		if p.offset >= len(p.input) || !isDigit(p.input[p.offset]) {
+			return 0, p.errorf(component, fmt.Sprintf("%d digits", n))
+		}
+
+		result = result*10 + int(p.input[p.offset]-'0')
+		p.offset++
+	}
+
+	if result < low || result > high {
+		p.offset = start
+
+		return 0, p.errorf(component, fmt.Sprintf("%0*d-%0*d", n, low, n, high))
+	}
+
+	return result, nil
+}
+
+// errorf returns a *ParseError for the component at the current offset.
+func (p *rfc3339Parser) errorf(component string, expected string) error {
+	return &ParseError{
+		Format:    p.format,
+		Input:     p.input,
+		Offset:    p.offset,
+		Component: component,
+		Expected:  expected,
+	}
+}
+
+// daysIn returns the number of days in the month of the year.
+func daysIn(year int, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// isDigit returns true if the character is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,304 @@
+package timetypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseRFC3339(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      time.Time
+		expectedError *timetypes.ParseError
+	}{
+		// RFC 3339 section 5.8 examples.
+		"rfc3339-example-fractional-seconds": {
+			input:    "1985-04-12T23:20:50.52Z",
+			expected: time.Date(1985, 4, 12, 23, 20, 50, 520000000, time.UTC),
+		},
+		"rfc3339-example-offset-negative": {
+			input:    "1996-12-19T16:39:57-08:00",
+			expected: time.Date(1996, 12, 19, 16, 39, 57, 0, time.FixedZone("", -8*60*60)),
+		},
+		"rfc3339-example-leap-second": {
+			input:    "1990-12-31T23:59:60Z",
+			expected: time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"rfc3339-example-leap-second-offset": {
+			input:    "1990-12-31T15:59:60-08:00",
+			expected: time.Date(1991, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"rfc3339-example-offset-minutes": {
+			input:    "1937-01-01T12:00:27.87+00:20",
+			expected: time.Date(1937, 1, 1, 12, 0, 27, 870000000, time.FixedZone("", 20*60)),
+		},
+
+		// Additional valid strings.
+		"valid-feb-29-leap-year": {
+			input:    "2004-02-29T00:00:00Z",
+			expected: time.Date(2004, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"valid-fractional-seconds-beyond-nanoseconds": {
+			input:    "2006-01-02T15:04:05.1234567891Z",
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+		},
+		"valid-leap-second-june": {
+			input:    "2015-06-30T23:59:60Z",
+			expected: time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"valid-lowercase-t-z": {
+			input:    "1985-04-12t23:20:50.52z",
+			expected: time.Date(1985, 4, 12, 23, 20, 50, 520000000, time.UTC),
+		},
+		"valid-offset-negative-zero": {
+			input:    "2006-01-02T15:04:05-00:00",
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"valid-year-zero": {
+			input:    "0000-01-01T00:00:00Z",
+			expected: time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+
+		// Invalid strings.
+		"invalid-empty": {
+			input: "",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "",
+				Offset:    0,
+				Component: "date-fullyear",
+				Expected:  "4 digits",
+			},
+		},
+		"invalid-date-fullyear-short": {
+			input: "206-01-02T15:04:05Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "206-01-02T15:04:05Z",
+				Offset:    3,
+				Component: "date-fullyear",
+				Expected:  "4 digits",
+			},
+		},
+		"invalid-date-month": {
+			input: "2006-13-02T15:04:05Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-13-02T15:04:05Z",
+				Offset:    5,
+				Component: "date-month",
+				Expected:  "01-12",
+			},
+		},
+		"invalid-date-mday-feb-29-non-leap-year": {
+			input: "2005-02-29T15:04:05Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2005-02-29T15:04:05Z",
+				Offset:    8,
+				Component: "date-mday",
+				Expected:  "01-28",
+			},
+		},
+		"invalid-date-mday-april-31": {
+			input: "2006-04-31T15:04:05Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-04-31T15:04:05Z",
+				Offset:    8,
+				Component: "date-mday",
+				Expected:  "01-30",
+			},
+		},
+		"invalid-full-date-separator": {
+			input: "2006/01/02T15:04:05Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006/01/02T15:04:05Z",
+				Offset:    4,
+				Component: "full-date",
+				Expected:  `"-"`,
+			},
+		},
+		"invalid-date-time-separator-space": {
+			input: "2006-01-02 15:04:05Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02 15:04:05Z",
+				Offset:    10,
+				Component: "date-time",
+				Expected:  `"T"`,
+			},
+		},
+		"invalid-time-hour": {
+			input: "2006-01-02T24:00:00Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T24:00:00Z",
+				Offset:    11,
+				Component: "time-hour",
+				Expected:  "00-23",
+			},
+		},
+		"invalid-time-minute": {
+			input: "2006-01-02T15:60:05Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:60:05Z",
+				Offset:    14,
+				Component: "time-minute",
+				Expected:  "00-59",
+			},
+		},
+		"invalid-time-minute-missing": {
+			input: "2006-01-02T15Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15Z",
+				Offset:    13,
+				Component: "partial-time",
+				Expected:  `":"`,
+			},
+		},
+		"invalid-time-second": {
+			input: "2006-01-02T15:04:61Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:61Z",
+				Offset:    17,
+				Component: "time-second",
+				Expected:  "00-60",
+			},
+		},
+		"invalid-time-second-leap-second-not-end-of-day": {
+			input: "2006-01-02T15:04:60Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:60Z",
+				Offset:    17,
+				Component: "time-second",
+				Expected:  "00-59, 60 is only valid for a leap second at the end of a month in UTC",
+			},
+		},
+		"invalid-time-second-leap-second-not-end-of-month": {
+			input: "1990-12-30T23:59:60Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "1990-12-30T23:59:60Z",
+				Offset:    17,
+				Component: "time-second",
+				Expected:  "00-59, 60 is only valid for a leap second at the end of a month in UTC",
+			},
+		},
+		"invalid-time-second-leap-second-not-utc": {
+			input: "1990-12-31T23:59:60+01:00",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "1990-12-31T23:59:60+01:00",
+				Offset:    17,
+				Component: "time-second",
+				Expected:  "00-59, 60 is only valid for a leap second at the end of a month in UTC",
+			},
+		},
+		"invalid-time-secfrac-comma": {
+			input: "2006-01-02T15:04:05,5Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:05,5Z",
+				Offset:    19,
+				Component: "time-offset",
+				Expected:  `"Z" or "+" or "-"`,
+			},
+		},
+		"invalid-time-secfrac-empty": {
+			input: "2006-01-02T15:04:05.Z",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:05.Z",
+				Offset:    20,
+				Component: "time-secfrac",
+				Expected:  "one or more digits",
+			},
+		},
+		"invalid-time-offset-missing": {
+			input: "2006-01-02T15:04:05",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:05",
+				Offset:    19,
+				Component: "time-offset",
+				Expected:  `"Z" or "+" or "-"`,
+			},
+		},
+		"invalid-time-numoffset-hour": {
+			input: "2006-01-02T15:04:05+24:00",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:05+24:00",
+				Offset:    20,
+				Component: "time-numoffset",
+				Expected:  "00-23",
+			},
+		},
+		"invalid-time-numoffset-minute": {
+			input: "2006-01-02T15:04:05+07:60",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:05+07:60",
+				Offset:    23,
+				Component: "time-numoffset",
+				Expected:  "00-59",
+			},
+		},
+		"invalid-time-numoffset-no-colon": {
+			input: "2006-01-02T15:04:05+0700",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:05+0700",
+				Offset:    22,
+				Component: "time-numoffset",
+				Expected:  `":"`,
+			},
+		},
+		"invalid-trailing-characters": {
+			input: "2006-01-02T15:04:05Z ",
+			expectedError: &timetypes.ParseError{
+				Format:    "RFC 3339",
+				Input:     "2006-01-02T15:04:05Z ",
+				Offset:    20,
+				Component: "date-time",
+				Expected:  "end of string",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := timetypes.ParseRFC3339(testCase.input)
+
+			var parseErr *timetypes.ParseError
+
+			if err != nil && !errors.As(err, &parseErr) {
+				t.Fatalf("expected *timetypes.ParseError, got: %T", err)
+			}
+
+			if diff := cmp.Diff(parseErr, testCase.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package timetypes
+
+import (
+	"time"
+)
+
+// RFC3339Precision is the fractional second precision used when creating
+// RFC3339 values from time.Time with RFC3339Type.ValueFromTime.
+type RFC3339Precision int
+
+const (
+	// RFC3339PrecisionAsProvided formats with only the fractional second
+	// digits necessary to represent the time, such as the time.RFC3339Nano
+	// layout. This is the default.
+	RFC3339PrecisionAsProvided RFC3339Precision = iota
+
+	// RFC3339PrecisionSeconds truncates fractional seconds, such as
+	// 2006-01-02T15:04:05Z.
+	RFC3339PrecisionSeconds
+
+	// RFC3339PrecisionMilliseconds always formats three fractional second
+	// digits, such as 2006-01-02T15:04:05.000Z.
+	RFC3339PrecisionMilliseconds
+
+	// RFC3339PrecisionMicroseconds always formats six fractional second
+	// digits, such as 2006-01-02T15:04:05.000000Z.
+	RFC3339PrecisionMicroseconds
+
+	// RFC3339PrecisionNanoseconds always formats nine fractional second
+	// digits, such as 2006-01-02T15:04:05.000000000Z.
+	RFC3339PrecisionNanoseconds
+)
+
+// format returns the time truncated to the precision and its string
+// representation.
+func (p RFC3339Precision) format(t time.Time) (time.Time, string) {
+	switch p {
+	case RFC3339PrecisionSeconds:
+		t = t.Truncate(time.Second)
+
+		return t, t.Format(time.RFC3339)
+	case RFC3339PrecisionMilliseconds:
+		t = t.Truncate(time.Millisecond)
+
+		return t, t.Format("2006-01-02T15:04:05.000Z07:00")
+	case RFC3339PrecisionMicroseconds:
+		t = t.Truncate(time.Microsecond)
+
+		return t, t.Format("2006-01-02T15:04:05.000000Z07:00")
+	case RFC3339PrecisionNanoseconds:
+		return t, t.Format("2006-01-02T15:04:05.000000000Z07:00")
+	default:
+		return t, t.Format(time.RFC3339Nano)
+	}
+}
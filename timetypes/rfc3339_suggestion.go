@@ -0,0 +1,70 @@
+package timetypes
+
+import (
+	"regexp"
+	"strings"
+)
+
+// rfc3339NearMissRegexp matches common near-miss timestamp strings, such as
+// those using a space instead of T, omitting seconds or the offset, or using
+// an offset without a colon.
+var rfc3339NearMissRegexp = regexp.MustCompile(
+	`^(\d{4}-\d{2}-\d{2})(?:[Tt]|\s+)(\d{2}:\d{2})(:\d{2})?(?:[.,](\d+))?\s*(Z|z|UTC|GMT|[+-]\d{2}(?::?\d{2})?)?$`,
+)
+
+// rfc3339Suggestion returns a corrected RFC 3339 string for a common
+// near-miss string or an empty string if there is no valid suggestion.
+func rfc3339Suggestion(s string) string {
+	dateTime, offset, ok := rfc3339NearMiss(s)
+
+	if !ok {
+		return ""
+	}
+
+	switch {
+	case offset == "", offset == "z", offset == "UTC", offset == "GMT":
+		offset = "Z"
+	case len(offset) == 3:
+		// +HH
+		offset += ":00"
+	case len(offset) == 5:
+		// +HHMM
+		offset = offset[:3] + ":" + offset[3:]
+	}
+
+	suggestion := dateTime + offset
+
+	if suggestion == s {
+		return ""
+	}
+
+	if _, err := ParseRFC3339(suggestion); err != nil {
+		return ""
+	}
+
+	return suggestion
+}
+
+// rfc3339NearMiss returns the corrected date and time without an offset of
+// a common near-miss string, such as 2006-01-02T15:04:00 for
+// 2006-01-02 15:04Z, and the offset as written, or false if the string does
+// not match rfc3339NearMissRegexp.
+func rfc3339NearMiss(s string) (string, string, bool) {
+	matches := rfc3339NearMissRegexp.FindStringSubmatch(strings.TrimSpace(s))
+
+	if matches == nil {
+		return "", "", false
+	}
+
+	date, hourMinute, second, fraction, offset := matches[1], matches[2], matches[3], matches[4], matches[5]
+
+	if second == "" {
+		second = ":00"
+	}
+
+	if fraction != "" {
+		fraction = "." + fraction
+	}
+
+	return date + "T" + hourMinute + second + fraction, offset, true
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -27,7 +29,7 @@ func TestRFC3339Equal(t *testing.T) {
 			expected: false,
 		},
 		"not-timetypes.RFC3339": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			other:    types.StringValue("2006-01-02T15:04:05Z"),
 			expected: false,
 		},
@@ -43,7 +45,7 @@ func TestRFC3339Equal(t *testing.T) {
 		},
 		"null-value": {
 			value:    timetypes.RFC3339Null(),
-			other:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			other:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			expected: false,
 		},
 		"unknown-null": {
@@ -58,27 +60,32 @@ func TestRFC3339Equal(t *testing.T) {
 		},
 		"unknown-value": {
 			value:    timetypes.RFC3339Unknown(),
-			other:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			other:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			expected: false,
 		},
 		"value-null": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			other:    timetypes.RFC3339Null(),
 			expected: false,
 		},
 		"value-unknown": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			other:    timetypes.RFC3339Unknown(),
 			expected: false,
 		},
 		"value-value-different": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
-			other:    timetypes.RFC3339Time(time.Date(2007, 2, 3, 16, 5, 6, 1, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			other:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2007, 2, 3, 16, 5, 6, 1, time.UTC)),
+			expected: false,
+		},
+		"value-value-different-string-same-time": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			other:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+00:00"),
 			expected: false,
 		},
 		"value-value-equal": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
-			other:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			other:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			expected: true,
 		},
 	}
@@ -98,6 +105,158 @@ func TestRFC3339Equal(t *testing.T) {
 	}
 }
 
+func TestRFC3339FromTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         time.Time
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"fractional-seconds": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.12Z"),
+		},
+		"offset": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60)),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+07:00"),
+		},
+		"year-0000": {
+			value:    time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: testValue(t, timetypes.RFC3339String, "0000-01-01T00:00:00Z"),
+		},
+		"year-9999": {
+			value:    time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC),
+			expected: testValue(t, timetypes.RFC3339String, "9999-12-31T23:59:59.999999999Z"),
+		},
+		"year-after-9999": {
+			value:    time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC 3339 Conversion Error",
+					"An unexpected error occurred while converting a time to an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 10000-01-01T00:00:00Z is outside the years 0000 to 9999, which RFC 3339 cannot represent.",
+				),
+			},
+		},
+		"year-before-0000": {
+			value:    time.Date(-1, 12, 31, 23, 59, 59, 0, time.UTC),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC 3339 Conversion Error",
+					"An unexpected error occurred while converting a time to an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time -0001-12-31T23:59:59Z is outside the years 0000 to 9999, which RFC 3339 cannot represent.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.RFC3339Time(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339InTimeZone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.RFC3339
+		zone          timetypes.TimeZone
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.RFC3339Null(),
+			zone:     testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.RFC3339Unknown(),
+			zone:     testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value-zone-null": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			zone:     timetypes.TimeZoneNull(),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+		},
+		"value-zone-unknown": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			zone:     timetypes.TimeZoneUnknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value-zone-value": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			zone:     testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T10:04:05-05:00"),
+		},
+		"value-zone-value-daylight-saving-time": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-07-02T15:04:05.500Z"),
+			zone:     testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-07-02T11:04:05.5-04:00"),
+		},
+		"value-zone-value-offset": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+07:00"),
+			zone:     testValue(t, timetypes.TimeZoneString, "Asia/Kolkata"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T13:34:05+05:30"),
+		},
+		"value-zone-value-utc": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+07:00"),
+			zone:     testValue(t, timetypes.TimeZoneString, "UTC"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T08:04:05Z"),
+		},
+		"value-zone-value-year-after-9999": {
+			value:    testValue(t, timetypes.RFC3339String, "9999-12-31T23:00:00Z"),
+			zone:     testValue(t, timetypes.TimeZoneString, "Asia/Tokyo"),
+			expected: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC 3339 Conversion Error",
+					"An unexpected error occurred while converting a time to an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 10000-01-01T08:00:00+09:00 is outside the years 0000 to 9999, which RFC 3339 cannot represent.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.InTimeZone(testCase.zone)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestRFC3339IsNull(t *testing.T) {
 	t.Parallel()
 
@@ -114,7 +273,7 @@ func TestRFC3339IsNull(t *testing.T) {
 			expected: false,
 		},
 		"value": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			expected: false,
 		},
 	}
@@ -150,7 +309,7 @@ func TestRFC3339IsUnknown(t *testing.T) {
 			expected: true,
 		},
 		"value": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			expected: false,
 		},
 	}
@@ -185,16 +344,60 @@ func TestRFC3339String(t *testing.T) {
 			value:    timetypes.RFC3339Unknown(),
 			expected: "<unknown>",
 		},
+		"value-fractional-seconds-1": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 100000000, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.1Z\"",
+		},
+		"value-fractional-seconds-2": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.12Z\"",
+		},
+		"value-fractional-seconds-3": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.123Z\"",
+		},
+		"value-fractional-seconds-4": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123400000, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.1234Z\"",
+		},
+		"value-fractional-seconds-5": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123450000, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.12345Z\"",
+		},
+		"value-fractional-seconds-6": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.123456Z\"",
+		},
+		"value-fractional-seconds-7": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456700, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.1234567Z\"",
+		},
+		"value-fractional-seconds-8": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456780, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.12345678Z\"",
+		},
+		"value-fractional-seconds-9": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC)),
+			expected: "\"2006-01-02T15:04:05.123456789Z\"",
+		},
 		"value-offset-negative": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))),
 			expected: "\"2006-01-02T15:04:05-07:00\"",
 		},
 		"value-offset-positive": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
 			expected: "\"2006-01-02T15:04:05+07:00\"",
 		},
+		"value-string-fractional-seconds-trailing-zeros": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.500Z"),
+			expected: "\"2006-01-02T15:04:05.500Z\"",
+		},
+		"value-string-offset-zero": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+00:00"),
+			expected: "\"2006-01-02T15:04:05+00:00\"",
+		},
 		"value-z": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			expected: "\"2006-01-02T15:04:05Z\"",
 		},
 	}
@@ -214,6 +417,80 @@ func TestRFC3339String(t *testing.T) {
 	}
 }
 
+func TestRFC3339StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.RFC3339
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.RFC3339": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			newValue: types.StringValue("2006-01-02T15:04:05Z"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RFC3339\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			newValue: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:06Z"),
+			expected: false,
+		},
+		"value-value-different-offset-same-time": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T08:04:05Z"),
+			newValue: testValue(t, timetypes.RFC3339String, "2006-01-02T10:04:05+02:00"),
+			expected: true,
+		},
+		"value-value-different-offset-different-time": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T08:04:05Z"),
+			newValue: testValue(t, timetypes.RFC3339String, "2006-01-02T08:04:05+02:00"),
+			expected: false,
+		},
+		"value-value-different-fractional-seconds-same-time": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.5Z"),
+			newValue: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.500Z"),
+			expected: true,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			newValue: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			expected: true,
+		},
+		"value-value-z-offset-zero": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+			newValue: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+00:00"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestRFC3339Time(t *testing.T) {
 	t.Parallel()
 
@@ -229,16 +506,52 @@ func TestRFC3339Time(t *testing.T) {
 			value:    timetypes.RFC3339Unknown(),
 			expected: time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
 		},
+		"value-fractional-seconds-1": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 100000000, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 100000000, time.UTC),
+		},
+		"value-fractional-seconds-2": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC),
+		},
+		"value-fractional-seconds-3": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC),
+		},
+		"value-fractional-seconds-4": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123400000, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123400000, time.UTC),
+		},
+		"value-fractional-seconds-5": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123450000, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123450000, time.UTC),
+		},
+		"value-fractional-seconds-6": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC),
+		},
+		"value-fractional-seconds-7": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456700, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456700, time.UTC),
+		},
+		"value-fractional-seconds-8": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456780, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456780, time.UTC),
+		},
+		"value-fractional-seconds-9": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+		},
 		"value-offset-negative": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))),
 			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60)),
 		},
 		"value-offset-positive": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
 			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60)),
 		},
 		"value-z": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
 		},
 	}
@@ -258,6 +571,51 @@ func TestRFC3339Time(t *testing.T) {
 	}
 }
 
+func TestRFC3339ToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.RFC3339
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.RFC3339Null(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.RFC3339Unknown(),
+			expected: types.StringUnknown(),
+		},
+		"value-string-offset-zero": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+00:00"),
+			expected: types.StringValue("2006-01-02T15:04:05+00:00"),
+		},
+		"value-time-z": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: types.StringValue("2006-01-02T15:04:05Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestRFC3339ToTerraformValue(t *testing.T) {
 	t.Parallel()
 
@@ -274,16 +632,60 @@ func TestRFC3339ToTerraformValue(t *testing.T) {
 			value:    timetypes.RFC3339Unknown(),
 			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 		},
+		"value-fractional-seconds-1": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 100000000, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1Z"),
+		},
+		"value-fractional-seconds-2": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12Z"),
+		},
+		"value-fractional-seconds-3": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123Z"),
+		},
+		"value-fractional-seconds-4": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123400000, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1234Z"),
+		},
+		"value-fractional-seconds-5": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123450000, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12345Z"),
+		},
+		"value-fractional-seconds-6": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123456Z"),
+		},
+		"value-fractional-seconds-7": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456700, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1234567Z"),
+		},
+		"value-fractional-seconds-8": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456780, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12345678Z"),
+		},
+		"value-fractional-seconds-9": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123456789Z"),
+		},
 		"value-offset-negative": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))),
 			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05-07:00"),
 		},
 		"value-offset-positive": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
 			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+07:00"),
 		},
+		"value-string-fractional-seconds-trailing-zeros": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.500Z"),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.500Z"),
+		},
+		"value-string-offset-zero": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+00:00"),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+00:00"),
+		},
 		"value-z": {
-			value:    timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
 		},
 	}
@@ -344,3 +746,55 @@ func TestRFC3339Type(t *testing.T) {
 		})
 	}
 }
+
+func TestRFC3339ValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.RFC3339
+		expected string
+	}{
+		"null": {
+			value:    timetypes.RFC3339Null(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.RFC3339Unknown(),
+			expected: "",
+		},
+		"value-string-fractional-seconds-trailing-zeros": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.500Z"),
+			expected: "2006-01-02T15:04:05.500Z",
+		},
+		"value-string-offset-zero": {
+			value:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+00:00"),
+			expected: "2006-01-02T15:04:05+00:00",
+		},
+		"value-time-fractional-seconds": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 500000000, time.UTC)),
+			expected: "2006-01-02T15:04:05.5Z",
+		},
+		"value-time-offset-positive": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
+			expected: "2006-01-02T15:04:05+07:00",
+		},
+		"value-time-z": {
+			value:    testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: "2006-01-02T15:04:05Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
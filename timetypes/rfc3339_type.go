@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -16,12 +17,30 @@ import (
 var (
 	_ tftypes.AttributePathStepper = RFC3339Type{}
 	_ attr.Type                    = RFC3339Type{}
+	_ basetypes.StringTypable      = RFC3339Type{}
 	_ xattr.TypeWithValidate       = RFC3339Type{}
+
+	_ timestampFormatWithSuggestion = RFC3339Type{}
+	_ timestampFormatWithValidate   = RFC3339Type{}
 )
 
 // RFC3339Type implements the attr.Type interface for usage in schema definitions
 // and data models.
-type RFC3339Type struct{}
+//
+// The zero value preserves any string representation and formats values
+// created from time.Time with only the necessary fractional second digits.
+// Type options only affect validation and the creation of values via
+// ValueFromTime, so types with differing options are still considered equal.
+type RFC3339Type struct {
+	// Precision is the fractional second precision of values created with
+	// ValueFromTime. Defaults to RFC3339PrecisionAsProvided.
+	Precision RFC3339Precision
+
+	// UTC controls whether values created with ValueFromTime are converted to
+	// UTC and whether Validate raises diagnostics for strings without the Z
+	// offset. Defaults to RFC3339UTCModeDisabled.
+	UTC RFC3339UTCMode
+}
 
 // ApplyTerraform5AttributePathStep always returns an error as this type
 // cannot be walked any further.
@@ -29,7 +48,7 @@ func (t RFC3339Type) ApplyTerraform5AttributePathStep(step tftypes.AttributePath
 	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
 }
 
-// Equal returns true if the given type is Type.
+// Equal returns true if the given type is RFC3339Type.
 func (t RFC3339Type) Equal(o attr.Type) bool {
 	_, ok := o.(RFC3339Type)
 
@@ -46,61 +65,83 @@ func (t RFC3339Type) TerraformType(_ context.Context) tftypes.Type {
 	return tftypes.String
 }
 
-// Validate ensures the value is always RFC 3339 conformant.
+// Validate ensures the value is always RFC 3339 conformant and, depending on
+// the UTC option, uses the Z offset.
 func (t RFC3339Type) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
-	if terraformValue.IsNull() || !terraformValue.IsKnown() {
-		return nil
-	}
+	return timestampValidate(t, terraformValue, schemaPath)
+}
 
-	var str string
+// ValueFromString converts the types.String into a value.
+func (t RFC3339Type) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	value, diags := timestampValueFromString(t, in)
 
-	err := terraformValue.As(&str)
+	return RFC3339{value}, diags
+}
 
-	if err != nil {
-		return diag.Diagnostics{
-			diag.NewAttributeErrorDiagnostic(
-				schemaPath,
-				"Invalid RFC 3339 Terraform Value",
-				"An unexpected error occurred while attempting to read a RFC 3339 string from the Terraform value. "+
+// ValueFromTime returns a known RFC3339 with the given time, formatted
+// according to the type options, or an error diagnostic if the formatted
+// year is outside 0000 to 9999.
+func (t RFC3339Type) ValueFromTime(value time.Time) (RFC3339, diag.Diagnostics) {
+	result := timestampTime(t, value)
+
+	if year := result.value.Year(); year < 0 || year > 9999 {
+		return RFC3339Unknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"RFC 3339 Conversion Error",
+				"An unexpected error occurred while converting a time to an RFC 3339 timestamp. "+
 					"Please contact the provider developers with the following:\n\n"+
-					"Error: "+err.Error(),
+					"Time "+value.Format(time.RFC3339Nano)+" is outside the years 0000 to 9999, which RFC 3339 cannot represent.",
 			),
 		}
 	}
 
-	_, diags := RFC3339String(str, schemaPath)
-
-	return diags
+	return RFC3339{result}, nil
 }
 
 // ValueFromTerraform converts the tftypes.Value into a value.
 func (t RFC3339Type) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
-	if terraformValue.IsNull() {
-		return RFC3339Null(), nil
-	}
+	value, err := timestampValueFromTerraform(t, terraformValue)
 
-	if !terraformValue.IsKnown() {
-		return RFC3339Unknown(), nil
-	}
+	return RFC3339{value}, err
+}
 
-	var str string
+// ValueType returns the associated attr.Value.
+func (t RFC3339Type) ValueType(_ context.Context) attr.Value {
+	return RFC3339{}
+}
 
-	err := terraformValue.As(&str)
+// describe returns the RFC 3339 format description for diagnostics.
+func (t RFC3339Type) describe() string {
+	return "The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00."
+}
 
-	if err != nil {
-		return RFC3339Unknown(), err
+// format returns the time and string representation according to the type
+// options.
+func (t RFC3339Type) format(value time.Time) (time.Time, string) {
+	if t.UTC.convert() {
+		value = value.UTC()
 	}
 
-	strTime, err := time.Parse(time.RFC3339, str)
+	return t.Precision.format(value)
+}
 
-	if err != nil {
-		return RFC3339Unknown(), err
-	}
+// name returns RFC 3339.
+func (t RFC3339Type) name() string {
+	return "RFC 3339"
+}
 
-	return RFC3339Time(strTime), nil
+// parse parses the string with ParseRFC3339.
+func (t RFC3339Type) parse(s string) (time.Time, error) {
+	return ParseRFC3339(s)
 }
 
-// ValueType returns the associated attr.Value.
-func (t RFC3339Type) ValueType(_ context.Context) attr.Value {
-	return RFC3339{}
+// suggestion returns a corrected RFC 3339 string for common near-miss
+// strings.
+func (t RFC3339Type) suggestion(s string) string {
+	return rfc3339Suggestion(s)
+}
+
+// validate returns any diagnostics for the UTC option.
+func (t RFC3339Type) validate(value timestamp, schemaPath path.Path) diag.Diagnostics {
+	return t.UTC.validate(value, schemaPath)
 }
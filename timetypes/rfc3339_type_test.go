@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -94,6 +95,11 @@ func TestRFC3339TypeEqual(t *testing.T) {
 			other:    timetypes.RFC3339Type{},
 			expected: true,
 		},
+		"timetypes.RFC3339Type-different-options": {
+			typ:      timetypes.RFC3339Type{},
+			other:    timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionMilliseconds},
+			expected: true,
+		},
 		"types.StringType": {
 			typ:      timetypes.RFC3339Type{},
 			other:    types.StringType,
@@ -215,10 +221,276 @@ func TestRFC3339TypeValidate(t *testing.T) {
 					"Invalid RFC 3339 String Value",
 					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
 						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
-						"Error: parsing time \"not-rfc3339-format\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"not-rfc3339-format\" as \"2006\"",
+						"Invalid date-fullyear at character 1, expected 4 digits:\n\n"+
+						"    not-rfc3339-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-date-month": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-13-02T15:04:05Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid date-month at character 6, expected 01-12:\n\n"+
+						"    2006-13-02T15:04:05Z\n"+
+						"         ^",
+				),
+			},
+		},
+		"string-value-invalid-fractional-seconds-comma": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05,5Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid time-offset at character 20, expected \"Z\" or \"+\" or \"-\":\n\n"+
+						"    2006-01-02T15:04:05,5Z\n"+
+						"                       ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05.5Z?",
+				),
+			},
+		},
+		"string-value-valid-fractional-seconds-1": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds-2": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds-3": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds-4": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1234Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds-5": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12345Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds-6": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123456Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds-7": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1234567Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds-8": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12345678Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-fractional-seconds-9": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123456789Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid-suggestion-date-time-separator-space": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02 15:04:05Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid date-time at character 11, expected \"T\":\n\n"+
+						"    2006-01-02 15:04:05Z\n"+
+						"              ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05Z?",
+				),
+			},
+		},
+		"string-value-invalid-suggestion-time-numoffset-no-colon": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+0700"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid time-numoffset at character 23, expected \":\":\n\n"+
+						"    2006-01-02T15:04:05+0700\n"+
+						"                          ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05+07:00?",
+				),
+			},
+		},
+		"string-value-invalid-suggestion-time-numoffset-no-minutes": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05-07"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid time-numoffset at character 23, expected \":\":\n\n"+
+						"    2006-01-02T15:04:05-07\n"+
+						"                          ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05-07:00?",
+				),
+			},
+		},
+		"string-value-invalid-suggestion-time-offset-missing": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid time-offset at character 20, expected \"Z\" or \"+\" or \"-\":\n\n"+
+						"    2006-01-02T15:04:05\n"+
+						"                       ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05Z?",
+				),
+			},
+		},
+		"string-value-invalid-suggestion-time-second-missing": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid partial-time at character 17, expected \":\":\n\n"+
+						"    2006-01-02T15:04Z\n"+
+						"                    ^\n\n"+
+						"Did you mean 2006-01-02T15:04:00Z?",
+				),
+			},
+		},
+		"string-value-invalid-suggestion-multiple": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02 15:04:05"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid date-time at character 11, expected \"T\":\n\n"+
+						"    2006-01-02 15:04:05\n"+
+						"              ^\n\n"+
+						"Did you mean 2006-01-02T15:04:05Z?",
+				),
+			},
+		},
+		"string-value-utc-convert-offset-positive": {
+			typ:            timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeConvert},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+07:00"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-utc-error-offset-positive": {
+			typ:            timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeError},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.5+07:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Non-UTC RFC 3339 String Value",
+					"A string value was provided that is not in UTC. "+
+						"This value must use the Z offset, such as 2006-01-02T15:04:05Z.\n\n"+
+						"Given Value: 2006-01-02T15:04:05.5+07:00\n"+
+						"Suggested Value: 2006-01-02T08:04:05.5Z",
+				),
+			},
+		},
+		"string-value-utc-error-offset-zero": {
+			typ:            timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeError},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+00:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Non-UTC RFC 3339 String Value",
+					"A string value was provided that is not in UTC. "+
+						"This value must use the Z offset, such as 2006-01-02T15:04:05Z.\n\n"+
+						"Given Value: 2006-01-02T15:04:05+00:00\n"+
+						"Suggested Value: 2006-01-02T15:04:05Z",
 				),
 			},
 		},
+		"string-value-utc-error-year-before-0000": {
+			typ:            timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeError},
+			terraformValue: tftypes.NewValue(tftypes.String, "0000-01-01T00:00:00+01:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Non-UTC RFC 3339 String Value",
+					"A string value was provided that is not in UTC. "+
+						"This value must use the Z offset, such as 2006-01-02T15:04:05Z.\n\n"+
+						"Given Value: 0000-01-01T00:00:00+01:00",
+				),
+			},
+		},
+		"string-value-utc-error-z": {
+			typ:            timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeError},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-utc-warning-offset-negative": {
+			typ:            timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeWarning},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05-07:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Non-UTC RFC 3339 String Value",
+					"A string value was provided that is not in UTC. "+
+						"This value must use the Z offset, such as 2006-01-02T15:04:05Z.\n\n"+
+						"Given Value: 2006-01-02T15:04:05-07:00\n"+
+						"Suggested Value: 2006-01-02T22:04:05Z",
+				),
+			},
+		},
+		"string-value-utc-warning-z": {
+			typ:            timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeWarning},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-leap-second": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1990-12-31T23:59:60Z"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-lowercase-t-z": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02t15:04:05z"),
+			schemaPath:     path.Root("test"),
+		},
 		"string-value-valid-offset-negative": {
 			typ:            timetypes.RFC3339Type{},
 			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05-07:00"),
@@ -251,6 +523,67 @@ func TestRFC3339TypeValidate(t *testing.T) {
 	}
 }
 
+func TestRFC3339TypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.RFC3339Type
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.RFC3339Type{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			typ:         timetypes.RFC3339Type{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.RFC3339Unknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.RFC3339Type{},
+			stringValue: types.StringValue("not-rfc3339-format"),
+			expected:    timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid RFC 3339 String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 format. "+
+						"The RFC 3339 string format is YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z or 2006-01-02T15:04:05+07:00.\n\n"+
+						"Invalid date-fullyear at character 1, expected 4 digits:\n\n"+
+						"    not-rfc3339-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.RFC3339Type{},
+			stringValue: types.StringValue("2006-01-02T15:04:05+00:00"),
+			expected:    testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05+00:00"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestRFC3339TypeValueFromTerraform(t *testing.T) {
 	t.Parallel()
 
@@ -280,22 +613,67 @@ func TestRFC3339TypeValueFromTerraform(t *testing.T) {
 			typ:            timetypes.RFC3339Type{},
 			terraformValue: tftypes.NewValue(tftypes.String, "not-rfc3339-format"),
 			expected:       timetypes.RFC3339Unknown(),
-			expectedError:  fmt.Errorf("parsing time \"not-rfc3339-format\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"not-rfc3339-format\" as \"2006\""),
+			expectedError:  fmt.Errorf("parsing \"not-rfc3339-format\" as RFC 3339: invalid date-fullyear at offset 0: expected 4 digits"),
+		},
+		"string-value-valid-fractional-seconds-1": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 100000000, time.UTC)),
+		},
+		"string-value-valid-fractional-seconds-2": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC)),
+		},
+		"string-value-valid-fractional-seconds-3": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC)),
+		},
+		"string-value-valid-fractional-seconds-4": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1234Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123400000, time.UTC)),
+		},
+		"string-value-valid-fractional-seconds-5": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12345Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123450000, time.UTC)),
+		},
+		"string-value-valid-fractional-seconds-6": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123456Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC)),
+		},
+		"string-value-valid-fractional-seconds-7": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.1234567Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456700, time.UTC)),
+		},
+		"string-value-valid-fractional-seconds-8": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.12345678Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456780, time.UTC)),
+		},
+		"string-value-valid-fractional-seconds-9": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.123456789Z"),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC)),
 		},
 		"string-value-valid-offset-negative": {
 			typ:            timetypes.RFC3339Type{},
 			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05-07:00"),
-			expected:       timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60))),
 		},
 		"string-value-valid-offset-positive": {
 			typ:            timetypes.RFC3339Type{},
 			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+07:00"),
-			expected:       timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60))),
 		},
 		"string-value-valid-z": {
 			typ:            timetypes.RFC3339Type{},
 			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
-			expected:       timetypes.RFC3339Time(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected:       testValueFrom(t, timetypes.RFC3339Time, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
 		},
 	}
 
@@ -328,6 +706,197 @@ func TestRFC3339TypeValueFromTerraform(t *testing.T) {
 	}
 }
 
+func TestRFC3339TypeValueFromTerraformToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.RFC3339Type
+		terraformValue tftypes.Value
+	}{
+		"fractional-seconds-trailing-zeros": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.500Z"),
+		},
+		"lowercase-t-z": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02t15:04:05z"),
+		},
+		"offset-negative": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05-07:00"),
+		},
+		"offset-zero": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+00:00"),
+		},
+		"z": {
+			typ:            timetypes.RFC3339Type{},
+			terraformValue: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+
+			got, err := value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.terraformValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339TypeValueFromTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.RFC3339Type
+		value         time.Time
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"as-provided-fractional-seconds": {
+			typ:      timetypes.RFC3339Type{},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC),
+			expected: "2006-01-02T15:04:05.12Z",
+		},
+		"as-provided-no-fractional-seconds": {
+			typ:      timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionAsProvided},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			expected: "2006-01-02T15:04:05Z",
+		},
+		"microseconds": {
+			typ:      timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionMicroseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: "2006-01-02T15:04:05.123456Z",
+		},
+		"milliseconds": {
+			typ:      timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionMilliseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: "2006-01-02T15:04:05.123Z",
+		},
+		"milliseconds-no-fractional-seconds": {
+			typ:      timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionMilliseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			expected: "2006-01-02T15:04:05.000Z",
+		},
+		"milliseconds-offset": {
+			typ:      timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionMilliseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 500000000, time.FixedZone("", 7*60*60)),
+			expected: "2006-01-02T15:04:05.500+07:00",
+		},
+		"milliseconds-year-after-9999": {
+			typ:   timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionMilliseconds},
+			value: time.Date(10000, 1, 1, 0, 0, 0, 500000000, time.UTC),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC 3339 Conversion Error",
+					"An unexpected error occurred while converting a time to an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 10000-01-01T00:00:00.5Z is outside the years 0000 to 9999, which RFC 3339 cannot represent.",
+				),
+			},
+		},
+		"nanoseconds": {
+			typ:      timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionNanoseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 120000000, time.UTC),
+			expected: "2006-01-02T15:04:05.120000000Z",
+		},
+		"seconds": {
+			typ:      timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionSeconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: "2006-01-02T15:04:05Z",
+		},
+		"utc-convert-milliseconds": {
+			typ:      timetypes.RFC3339Type{Precision: timetypes.RFC3339PrecisionMilliseconds, UTC: timetypes.RFC3339UTCModeConvert},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 500000000, time.FixedZone("", 7*60*60)),
+			expected: "2006-01-02T08:04:05.500Z",
+		},
+		"utc-convert-offset-negative": {
+			typ:      timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeConvert},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60)),
+			expected: "2006-01-02T22:04:05Z",
+		},
+		"utc-convert-year-before-0000": {
+			typ:   timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeConvert},
+			value: time.Date(0, 1, 1, 0, 0, 0, 0, time.FixedZone("", 7*60*60)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC 3339 Conversion Error",
+					"An unexpected error occurred while converting a time to an RFC 3339 timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 0000-01-01T00:00:00+07:00 is outside the years 0000 to 9999, which RFC 3339 cannot represent.",
+				),
+			},
+		},
+		"utc-disabled-offset-negative": {
+			typ:      timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeDisabled},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", -7*60*60)),
+			expected: "2006-01-02T15:04:05-07:00",
+		},
+		"utc-error-offset-positive": {
+			typ:      timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeError},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60)),
+			expected: "2006-01-02T08:04:05Z",
+		},
+		"utc-warning-offset-positive": {
+			typ:      timetypes.RFC3339Type{UTC: timetypes.RFC3339UTCModeWarning},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("", 7*60*60)),
+			expected: "2006-01-02T08:04:05Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromTime(testCase.value)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				if !got.IsUnknown() {
+					t.Errorf("expected unknown value, got: %s", got)
+				}
+
+				return
+			}
+
+			if diff := cmp.Diff(got.ValueString(), testCase.expected); diff != "" {
+				t.Errorf("unexpected string difference: %s", diff)
+			}
+
+			expectedTime, err := time.Parse(time.RFC3339Nano, testCase.expected)
+
+			if err != nil {
+				t.Fatalf("unexpected error parsing expected: %s", err)
+			}
+
+			if !got.Time().Equal(expectedTime) {
+				t.Errorf("expected time %s, got: %s", expectedTime, got.Time())
+			}
+		})
+	}
+}
+
 func TestRFC3339TypeValueType(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,80 @@
+package timetypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// RFC3339UTCMode controls whether RFC3339Type normalizes values created from
+// time.Time to UTC and whether it validates that strings use the Z offset.
+type RFC3339UTCMode int
+
+const (
+	// RFC3339UTCModeDisabled neither converts nor validates offsets. This is
+	// the default.
+	RFC3339UTCModeDisabled RFC3339UTCMode = iota
+
+	// RFC3339UTCModeConvert converts values created with
+	// RFC3339Type.ValueFromTime to UTC.
+	RFC3339UTCModeConvert
+
+	// RFC3339UTCModeWarning converts values created with
+	// RFC3339Type.ValueFromTime to UTC and returns a warning diagnostic
+	// during validation for strings without the Z offset.
+	RFC3339UTCModeWarning
+
+	// RFC3339UTCModeError converts values created with
+	// RFC3339Type.ValueFromTime to UTC and returns an error diagnostic
+	// during validation for strings without the Z offset.
+	RFC3339UTCModeError
+)
+
+// convert returns true if values created from time.Time should be converted
+// to UTC.
+func (m RFC3339UTCMode) convert() bool {
+	switch m {
+	case RFC3339UTCModeConvert, RFC3339UTCModeWarning, RFC3339UTCModeError:
+		return true
+	default:
+		return false
+	}
+}
+
+// validate returns a diagnostic, based on the mode, if the given timestamp is
+// not using the Z offset.
+func (m RFC3339UTCMode) validate(value timestamp, schemaPath path.Path) diag.Diagnostics {
+	if m != RFC3339UTCModeWarning && m != RFC3339UTCModeError {
+		return nil
+	}
+
+	valueString := value.ValueString()
+
+	if valueString == "" {
+		return nil
+	}
+
+	if last := valueString[len(valueString)-1]; last == 'Z' || last == 'z' {
+		return nil
+	}
+
+	summary := "Non-UTC RFC 3339 String Value"
+	detail := "A string value was provided that is not in UTC. " +
+		"This value must use the Z offset, such as 2006-01-02T15:04:05Z.\n\n" +
+		"Given Value: " + valueString
+
+	// The value in UTC can be outside the years 0000 to 9999, such as
+	// 0000-01-01T00:00:00+01:00, in which case there is no suggestion.
+	if suggestion, diags := RFC3339Time(value.Time().UTC()); !diags.HasError() {
+		detail += "\nSuggested Value: " + suggestion.ValueString()
+	}
+
+	if m == RFC3339UTCModeWarning {
+		return diag.Diagnostics{
+			diag.NewAttributeWarningDiagnostic(schemaPath, summary, detail),
+		}
+	}
+
+	return diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(schemaPath, summary, detail),
+	}
+}
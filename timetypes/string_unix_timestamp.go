@@ -0,0 +1,178 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = StringUnixTimestamp{}
+	_ basetypes.StringValuable                   = StringUnixTimestamp{}
+	_ basetypes.StringValuableWithSemanticEquals = StringUnixTimestamp{}
+)
+
+// StringUnixTimestampNull returns a null StringUnixTimestamp in seconds. Use
+// StringUnixTimestampType.NullValue for other units.
+func StringUnixTimestampNull() StringUnixTimestamp {
+	return StringUnixTimestamp{
+		stringValue: stringValue{
+			null: true,
+		},
+	}
+}
+
+// StringUnixTimestampString returns a known StringUnixTimestamp or any errors
+// while attempting to parse the string as a whole number of seconds since the
+// Unix epoch. Use StringUnixTimestampType.ValueFromString for other units.
+func StringUnixTimestampString(s string, schemaPath path.Path) (StringUnixTimestamp, diag.Diagnostics) {
+	return stringUnixTimestampString(s, UnixTimestampUnitSeconds, schemaPath)
+}
+
+// StringUnixTimestampTime returns a known StringUnixTimestamp with the given
+// time in seconds. Any fractional seconds are truncated. An error diagnostic
+// is returned if the time is outside the years 0000 to 9999. Use
+// StringUnixTimestampType.ValueFromTime for other units.
+func StringUnixTimestampTime(t time.Time) (StringUnixTimestamp, diag.Diagnostics) {
+	return StringUnixTimestampType{}.ValueFromTime(t)
+}
+
+// StringUnixTimestampUnknown returns an unknown StringUnixTimestamp in
+// seconds. Use StringUnixTimestampType.UnknownValue for other units.
+func StringUnixTimestampUnknown() StringUnixTimestamp {
+	return StringUnixTimestamp{
+		stringValue: stringValue{
+			unknown: true,
+		},
+	}
+}
+
+// StringUnixTimestamp implements the attr.Value interface for usage in logic.
+// It represents a whole number of units since the Unix epoch,
+// 1970-01-01T00:00:00Z, which is kept as a string, such as "1136214245".
+type StringUnixTimestamp struct {
+	stringValue
+
+	unit  UnixTimestampUnit
+	value int64
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a StringUnixTimestamp type
+//   - Has the same null, unknown, unit, and string representation data
+//
+// Use StringSemanticEquals to compare the represented numbers instead.
+func (v StringUnixTimestamp) Equal(o attr.Value) bool {
+	otherValue, ok := o.(StringUnixTimestamp)
+
+	if !ok {
+		return false
+	}
+
+	if otherValue.null != v.null {
+		return false
+	}
+
+	if otherValue.unknown != v.unknown {
+		return false
+	}
+
+	if otherValue.unit != v.unit {
+		return false
+	}
+
+	return otherValue.valueString == v.valueString
+}
+
+// StringSemanticEquals returns true if the given StringUnixTimestamp
+// represents the same number of units, regardless of the string
+// representation, such as 01136214245 and 1136214245. The framework calls
+// this method to keep the prior value and prevent unexpected differences.
+func (v StringUnixTimestamp) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(StringUnixTimestamp)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.unit == newValue.unit && v.value == newValue.value, diags
+}
+
+// Time returns the time.Time of a known StringUnixTimestamp in UTC. The zero
+// time.Time is returned for a null or unknown StringUnixTimestamp.
+func (v StringUnixTimestamp) Time() time.Time {
+	if v.null || v.unknown {
+		return time.Time{}
+	}
+
+	return v.unit.toTime(v.value)
+}
+
+// ToRFC3339 converts the StringUnixTimestamp to an RFC3339 in UTC with only
+// the necessary fractional second digits. A null or unknown
+// StringUnixTimestamp returns a null or unknown RFC3339.
+func (v StringUnixTimestamp) ToRFC3339() (RFC3339, diag.Diagnostics) {
+	if v.null {
+		return RFC3339Null(), nil
+	}
+
+	if v.unknown {
+		return RFC3339Unknown(), nil
+	}
+
+	return v.unit.toRFC3339(v.value)
+}
+
+// Type returns the attr.Type of StringUnixTimestamp.
+func (v StringUnixTimestamp) Type(_ context.Context) attr.Type {
+	return StringUnixTimestampType{
+		Unit: v.unit,
+	}
+}
+
+// ValueInt64 returns the number of units since the Unix epoch of a known
+// StringUnixTimestamp. Zero is returned for a null or unknown
+// StringUnixTimestamp.
+func (v StringUnixTimestamp) ValueInt64() int64 {
+	return v.value
+}
+
+// stringUnixTimestampString returns a known StringUnixTimestamp in the unit
+// or any errors while attempting to parse the string.
+func stringUnixTimestampString(s string, unit UnixTimestampUnit, schemaPath path.Path) (StringUnixTimestamp, diag.Diagnostics) {
+	value, err := unit.parse(s)
+
+	if err != nil {
+		return StringUnixTimestamp{
+				stringValue: stringValue{
+					unknown: true,
+				},
+				unit: unit,
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of "+unit.String()+" since 1970-01-01T00:00:00Z, such as "+unit.example()+".\n\n"+
+						parseErrorDetail(err),
+				),
+			}
+	}
+
+	return StringUnixTimestamp{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		unit:  unit,
+		value: value,
+	}, nil
+}
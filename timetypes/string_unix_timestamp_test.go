@@ -0,0 +1,588 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStringUnixTimestampEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.StringUnixTimestamp
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.StringUnixTimestampNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.StringUnixTimestamp": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			other:    types.StringValue("1136214245"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			other:    timetypes.StringUnixTimestampNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.StringUnixTimestampNull(),
+			other:    timetypes.StringUnixTimestampUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.StringUnixTimestampNull(),
+			other:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			other:    timetypes.StringUnixTimestampNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			other:    timetypes.StringUnixTimestampUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			other:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			other:    timetypes.StringUnixTimestampNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			other:    timetypes.StringUnixTimestampUnknown(),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			other:    testValue(t, timetypes.StringUnixTimestampString, "1136214246"),
+			expected: false,
+		},
+		"value-value-different-string-same-number": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "01136214245"),
+			other:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: false,
+		},
+		"value-value-different-unit": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			other:    testValueFromString[timetypes.StringUnixTimestamp](t, timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}, "1136214245"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			other:    testValueFrom(t, timetypes.StringUnixTimestampTime, time.Date(2006, 1, 2, 15, 4, 5, 999, time.UTC)),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.StringUnixTimestamp
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.StringUnixTimestamp
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.StringUnixTimestamp
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.StringUnixTimestamp": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			newValue: types.StringValue("1136214245"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.StringUnixTimestamp\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			newValue: testValue(t, timetypes.StringUnixTimestampString, "1136214246"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			newValue: testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: true,
+		},
+		"value-value-leading-zeros": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "01136214245"),
+			newValue: testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: true,
+		},
+		"value-value-negative-zero": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "-0"),
+			newValue: testValue(t, timetypes.StringUnixTimestampString, "0"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.StringUnixTimestamp
+		expected time.Time
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: time.Time{},
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: time.Time{},
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"value-microseconds": {
+			value:    testValueFromString[timetypes.StringUnixTimestamp](t, timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMicroseconds}, "1136214245123456"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC),
+		},
+		"value-milliseconds": {
+			value:    testValueFromString[timetypes.StringUnixTimestamp](t, timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}, "1136214245123"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC),
+		},
+		"value-nanoseconds": {
+			value:    testValueFromString[timetypes.StringUnixTimestamp](t, timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds}, "1136214245123456789"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+		},
+		"value-negative": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "-1"),
+			expected: time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Time()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampToRFC3339(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.StringUnixTimestamp
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+		},
+		"value-milliseconds": {
+			value:    testValueFromString[timetypes.StringUnixTimestamp](t, timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}, "1136214245100"),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.1Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToRFC3339()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.StringUnixTimestamp
+		expected string
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: "\"1136214245\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.StringUnixTimestamp
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "01136214245"),
+			expected: types.StringValue("01136214245"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.StringUnixTimestamp
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+			expected: tftypes.NewValue(tftypes.String, "1136214245"),
+		},
+		"value-time": {
+			value:    testValueFrom(t, timetypes.StringUnixTimestampTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "1136214245"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.StringUnixTimestamp
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: timetypes.StringUnixTimestampType{},
+		},
+		"milliseconds": {
+			value:    testValueFromString[timetypes.StringUnixTimestamp](t, timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}, "1136214245000"),
+			expected: timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampValueInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.StringUnixTimestamp
+		expected int64
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: 0,
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: 0,
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "01136214245"),
+			expected: 1136214245,
+		},
+		"value-negative": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "-1136214245"),
+			expected: -1136214245,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueInt64()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.StringUnixTimestamp
+		expected string
+	}{
+		"null": {
+			value:    timetypes.StringUnixTimestampNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.StringUnixTimestampUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.StringUnixTimestampString, "01136214245"),
+			expected: "01136214245",
+		},
+		"value-time": {
+			value:    testValueFrom(t, timetypes.StringUnixTimestampTime, time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC)),
+			expected: "-1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
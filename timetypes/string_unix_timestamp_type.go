@@ -0,0 +1,197 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = StringUnixTimestampType{}
+	_ attr.Type                    = StringUnixTimestampType{}
+	_ basetypes.StringTypable      = StringUnixTimestampType{}
+	_ xattr.TypeWithValidate       = StringUnixTimestampType{}
+)
+
+// StringUnixTimestampType implements the attr.Type interface for usage in
+// schema definitions and data models. Values are strings of whole numbers of
+// units since the Unix epoch, such as "1136214245" seconds, which avoids any
+// precision loss of Terraform numbers.
+//
+// The zero value uses seconds. Since the unit changes the meaning of values,
+// types with differing units are not considered equal.
+type StringUnixTimestampType struct {
+	// Unit is the unit of numbers since the Unix epoch. Defaults to
+	// UnixTimestampUnitSeconds.
+	Unit UnixTimestampUnit
+}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t StringUnixTimestampType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is StringUnixTimestampType with the
+// same unit.
+func (t StringUnixTimestampType) Equal(o attr.Type) bool {
+	other, ok := o.(StringUnixTimestampType)
+
+	if !ok {
+		return false
+	}
+
+	return t.Unit == other.Unit
+}
+
+// NullValue returns a null StringUnixTimestamp in the unit of the type.
+// Use this instead of StringUnixTimestampNull for units other than seconds,
+// such as for collection elements, since the value type includes the unit.
+func (t StringUnixTimestampType) NullValue() StringUnixTimestamp {
+	return StringUnixTimestamp{
+		stringValue: stringValue{
+			null: true,
+		},
+		unit: t.Unit,
+	}
+}
+
+// String returns a human readable string of the type.
+func (t StringUnixTimestampType) String() string {
+	return "timetypes.StringUnixTimestampType[" + t.Unit.String() + "]"
+}
+
+// TerraformType always returns tftypes.String.
+func (t StringUnixTimestampType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// UnknownValue returns an unknown StringUnixTimestamp in the unit of the
+// type. Use this instead of StringUnixTimestampUnknown for units other than
+// seconds, such as for collection elements, since the value type includes the
+// unit.
+func (t StringUnixTimestampType) UnknownValue() StringUnixTimestamp {
+	return StringUnixTimestamp{
+		stringValue: stringValue{
+			unknown: true,
+		},
+		unit: t.Unit,
+	}
+}
+
+// Validate ensures the value is always a string of a whole number of units
+// which fits in an int64.
+func (t StringUnixTimestampType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid Unix Timestamp Terraform Value",
+				"An unexpected error occurred while attempting to read a Unix timestamp string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := stringUnixTimestampString(str, t.Unit, schemaPath)
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t StringUnixTimestampType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return t.NullValue(), nil
+	}
+
+	if in.IsUnknown() {
+		return t.UnknownValue(), nil
+	}
+
+	return stringUnixTimestampString(in.ValueString(), t.Unit, path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t StringUnixTimestampType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return t.NullValue(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return t.UnknownValue(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return t.UnknownValue(), err
+	}
+
+	value, err := t.Unit.parse(str)
+
+	if err != nil {
+		return t.UnknownValue(), err
+	}
+
+	return StringUnixTimestamp{
+		stringValue: stringValue{
+			valueString: str,
+		},
+		unit:  t.Unit,
+		value: value,
+	}, nil
+}
+
+// ValueFromTime returns a known StringUnixTimestamp with the given time in
+// the unit, truncating any smaller units. An error diagnostic is returned if
+// the time is outside the years 0000 to 9999 or does not fit in an int64 of
+// the unit, such as times after the year 2262 in nanoseconds.
+func (t StringUnixTimestampType) ValueFromTime(value time.Time) (StringUnixTimestamp, diag.Diagnostics) {
+	number, err := t.Unit.fromTime(value)
+
+	if err != nil {
+		return t.UnknownValue(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Unix Timestamp Time Value",
+				"An unexpected error occurred while converting a time to a Unix timestamp. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	return StringUnixTimestamp{
+		stringValue: stringValue{
+			valueString: strconv.FormatInt(number, 10),
+		},
+		unit:  t.Unit,
+		value: number,
+	}, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t StringUnixTimestampType) ValueType(_ context.Context) attr.Value {
+	return StringUnixTimestamp{
+		unit: t.Unit,
+	}
+}
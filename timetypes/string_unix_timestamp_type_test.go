@@ -0,0 +1,811 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStringUnixTimestampTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.StringUnixTimestampType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.StringUnixTimestampType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.StringUnixTimestampType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.StringUnixTimestampType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.StringUnixTimestampType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.StringUnixTimestampType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.StringUnixTimestampType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.StringUnixTimestampType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.StringUnixTimestampType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeListElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ timetypes.StringUnixTimestampType
+	}{
+		"milliseconds": {
+			typ: timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+		},
+		"seconds": {
+			typ: timetypes.StringUnixTimestampType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			known, diags := testCase.typ.ValueFromTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC))
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			got, diags := basetypes.NewListValue(
+				testCase.typ,
+				[]attr.Value{
+					testCase.typ.NullValue(),
+					testCase.typ.UnknownValue(),
+					known,
+				},
+			)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got.ElementType(context.Background()), testCase.typ); diff != "" {
+				t.Errorf("unexpected element type difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.StringUnixTimestampType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.StringUnixTimestampType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.StringUnixTimestampType": {
+			typ:      timetypes.StringUnixTimestampType{},
+			other:    timetypes.StringUnixTimestampType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.StringUnixTimestampType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.StringUnixTimestampType{},
+			other:    types.StringType,
+			expected: false,
+		},
+		"timetypes.StringUnixTimestampType-different-unit": {
+			typ:      timetypes.StringUnixTimestampType{},
+			other:    timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			expected: false,
+		},
+		"timetypes.UnixTimestampType": {
+			typ:      timetypes.StringUnixTimestampType{},
+			other:    timetypes.UnixTimestampType{},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeNullValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ timetypes.StringUnixTimestampType
+	}{
+		"milliseconds": {
+			typ: timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+		},
+		"seconds": {
+			typ: timetypes.StringUnixTimestampType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.NullValue()
+
+			if !got.IsNull() {
+				t.Errorf("expected null value, got: %s", got)
+			}
+
+			if diff := cmp.Diff(got.Type(context.Background()), testCase.typ); diff != "" {
+				t.Errorf("unexpected type difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.StringUnixTimestampType
+		expected string
+	}{
+		"default": {
+			typ:      timetypes.StringUnixTimestampType{},
+			expected: "timetypes.StringUnixTimestampType[seconds]",
+		},
+		"milliseconds": {
+			typ:      timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			expected: "timetypes.StringUnixTimestampType[milliseconds]",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.StringUnixTimestampType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.StringUnixTimestampType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeUnknownValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ timetypes.StringUnixTimestampType
+	}{
+		"milliseconds": {
+			typ: timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+		},
+		"seconds": {
+			typ: timetypes.StringUnixTimestampType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.UnknownValue()
+
+			if !got.IsUnknown() {
+				t.Errorf("expected unknown value, got: %s", got)
+			}
+
+			if diff := cmp.Diff(got.Type(context.Background()), testCase.typ); diff != "" {
+				t.Errorf("unexpected type difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.StringUnixTimestampType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, 1136214245),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Terraform Value",
+					"An unexpected error occurred while attempting to read a Unix timestamp string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Number into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-timestamp-format"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected \"-\" or digit:\n\n"+
+						"    not-timestamp-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-empty": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, ""),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected one or more digits:\n\n"+
+						"    \n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-fractional": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245.5"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 11, expected digit or end of string:\n\n"+
+						"    1136214245.5\n"+
+						"              ^",
+				),
+			},
+		},
+		"string-value-invalid-milliseconds-fractional": {
+			typ:            timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245000.5"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of milliseconds since 1970-01-01T00:00:00Z, such as 1136214245000.\n\n"+
+						"Invalid milliseconds at character 14, expected digit or end of string:\n\n"+
+						"    1136214245000.5\n"+
+						"                 ^",
+				),
+			},
+		},
+		"string-value-invalid-minus-only": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 2, expected one or more digits:\n\n"+
+						"    -\n"+
+						"     ^",
+				),
+			},
+		},
+		"string-value-invalid-overflow": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "9223372036854775808"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected -62167219200 to 253402300799:\n\n"+
+						"    9223372036854775808\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-max-int64": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "9223372036854775807"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected -62167219200 to 253402300799:\n\n"+
+						"    9223372036854775807\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-min-int64": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-9223372036854775808"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected -62167219200 to 253402300799:\n\n"+
+						"    -9223372036854775808\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-plus": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "+1136214245"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected \"-\" or digit:\n\n"+
+						"    +1136214245\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-whitespace": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, " 1136214245"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected \"-\" or digit:\n\n"+
+						"     1136214245\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-valid": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid-year-after-9999": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "253402300800"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected -62167219200 to 253402300799:\n\n"+
+						"    253402300800\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-valid-max": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "253402300799"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-max-nanoseconds": {
+			typ:            timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds},
+			terraformValue: tftypes.NewValue(tftypes.String, "9223372036854775807"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-min": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-62167219200"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-milliseconds": {
+			typ:            timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245000"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-negative": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-1136214245"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.StringUnixTimestampType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.StringUnixTimestampType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.StringUnixTimestampNull(),
+		},
+		"unknown": {
+			typ:         timetypes.StringUnixTimestampType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.StringUnixTimestampUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.StringUnixTimestampType{},
+			stringValue: types.StringValue("not-timestamp-format"),
+			expected:    timetypes.StringUnixTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Unix Timestamp String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp string is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Invalid seconds at character 1, expected \"-\" or digit:\n\n"+
+						"    not-timestamp-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.StringUnixTimestampType{},
+			stringValue: types.StringValue("1136214245"),
+			expected:    testValue(t, timetypes.StringUnixTimestampString, "1136214245"),
+		},
+		"value-valid-milliseconds": {
+			typ:         timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			stringValue: types.StringValue("1136214245000"),
+			expected:    testValueFromString[timetypes.StringUnixTimestamp](t, timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}, "1136214245000"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.StringUnixTimestampType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, 1136214245),
+			expected:       timetypes.StringUnixTimestampUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Number into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.StringUnixTimestampNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.StringUnixTimestampUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245.5"),
+			expected:       timetypes.StringUnixTimestampUnknown(),
+			expectedError:  fmt.Errorf(`parsing "1136214245.5" as Unix timestamp: invalid seconds at offset 10: expected digit or end of string`),
+		},
+		"string-value-invalid-year-after-9999": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1000000000000000000"),
+			expected:       timetypes.StringUnixTimestampUnknown(),
+			expectedError:  fmt.Errorf(`parsing "1000000000000000000" as Unix timestamp: invalid seconds at offset 0: expected -62167219200 to 253402300799`),
+		},
+		"string-value-valid": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245"),
+			expected:       testValueFrom(t, timetypes.StringUnixTimestampTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+		},
+		"string-value-valid-preserved": {
+			typ:            timetypes.StringUnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "01136214245"),
+			expected:       testValue(t, timetypes.StringUnixTimestampString, "01136214245"),
+		},
+		"string-value-valid-nanoseconds": {
+			typ:            timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245000000000"),
+			expected:       testValueFromString[timetypes.StringUnixTimestamp](t, timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds}, "1136214245000000000"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeValueFromTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.StringUnixTimestampType
+		value         time.Time
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"microseconds": {
+			typ:      timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMicroseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: "1136214245123456",
+		},
+		"milliseconds": {
+			typ:      timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: "1136214245123",
+		},
+		"nanoseconds-overflow": {
+			typ:   timetypes.StringUnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds},
+			value: time.Date(2263, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Time Value",
+					"An unexpected error occurred while converting a time to a Unix timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: time 2263-01-01T00:00:00Z is outside the range of -9223372036854775808 to 9223372036854775807 nanoseconds",
+				),
+			},
+		},
+		"seconds": {
+			typ:      timetypes.StringUnixTimestampType{},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: "1136214245",
+		},
+		"seconds-year-after-9999": {
+			typ:   timetypes.StringUnixTimestampType{},
+			value: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Time Value",
+					"An unexpected error occurred while converting a time to a Unix timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: time 10000-01-01T00:00:00Z is outside the range of -62167219200 to 253402300799 seconds",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromTime(testCase.value)
+
+			if diff := cmp.Diff(got.ValueString(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Type(context.Background()), testCase.typ); diff != "" {
+				t.Errorf("unexpected type difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringUnixTimestampTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.StringUnixTimestampType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.StringUnixTimestampType{},
+			expected: timetypes.StringUnixTimestamp{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stringValue is the shared implementation of string values which preserve
+// their original string representation, such as GoDuration. Value types
+// embed it alongside their parsed data and implement the methods which
+// depend on the concrete type, such as Equal, StringSemanticEquals, and Type.
+type stringValue struct {
+	null    bool
+	unknown bool
+
+	// valueString is the original string representation, which is preserved
+	// so Terraform always receives the same string it sent.
+	valueString string
+}
+
+// IsNull returns true if the value represents a null Value.
+func (v stringValue) IsNull() bool {
+	return v.null
+}
+
+// IsUnknown returns true if the value represents an unknown Value.
+func (v stringValue) IsUnknown() bool {
+	return v.unknown
+}
+
+// String returns a human readable string of the value.
+func (v stringValue) String() string {
+	if v.null {
+		return attr.NullValueString
+	}
+
+	if v.unknown {
+		return attr.UnknownValueString
+	}
+
+	return `"` + v.valueString + `"`
+}
+
+// ToStringValue converts the value to a types.String.
+func (v stringValue) ToStringValue(_ context.Context) (basetypes.StringValue, diag.Diagnostics) {
+	if v.null {
+		return basetypes.NewStringNull(), nil
+	}
+
+	if v.unknown {
+		return basetypes.NewStringUnknown(), nil
+	}
+
+	return basetypes.NewStringValue(v.valueString), nil
+}
+
+// ToTerraformValue converts the value to a tftypes.String.
+func (v stringValue) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	if v.null {
+		return tftypes.NewValue(tftypes.String, nil), nil
+	}
+
+	if v.unknown {
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+
+	return tftypes.NewValue(tftypes.String, v.valueString), nil
+}
+
+// ValueString returns the original string representation of a known value.
+// An empty string is returned for a null or unknown value.
+func (v stringValue) ValueString() string {
+	return v.valueString
+}
+
+// equal returns true if the values have the same null, unknown, and string
+// representation data.
+func (v stringValue) equal(o stringValue) bool {
+	if o.null != v.null {
+		return false
+	}
+
+	if o.unknown != v.unknown {
+		return false
+	}
+
+	return o.valueString == v.valueString
+}
+
+// semanticEqualityCheckError returns the error diagnostic for a
+// StringSemanticEquals method which received an unexpected value type.
+func semanticEqualityCheckError(v basetypes.StringValuable, newValuable basetypes.StringValuable) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Semantic Equality Check Error",
+		"An unexpected value type was received while performing semantic equality checks. "+
+			"Please report this to the provider developers.\n\n"+
+			"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+			"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+	)
+}
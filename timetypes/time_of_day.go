@@ -0,0 +1,191 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = TimeOfDay{}
+	_ basetypes.StringValuable                   = TimeOfDay{}
+	_ basetypes.StringValuableWithSemanticEquals = TimeOfDay{}
+)
+
+// TimeOfDayNull returns a null TimeOfDay.
+func TimeOfDayNull() TimeOfDay {
+	return TimeOfDay{
+		stringValue: stringValue{
+			null: true,
+		},
+	}
+}
+
+// TimeOfDayString returns a known TimeOfDay or any errors while attempting
+// to parse the string as RFC 3339 partial-time format.
+func TimeOfDayString(s string, schemaPath path.Path) (TimeOfDay, diag.Diagnostics) {
+	hour, minute, second, nanosecond, err := parseTimeOfDay(s)
+
+	if err != nil {
+		return TimeOfDay{
+				stringValue: stringValue{
+					unknown: true,
+				},
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						parseErrorDetail(err),
+				),
+			}
+	}
+
+	return TimeOfDay{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		hour:       hour,
+		minute:     minute,
+		second:     second,
+		nanosecond: nanosecond,
+	}, nil
+}
+
+// TimeOfDayTime returns a known TimeOfDay with the wall clock time of the
+// given time in its location. The string representation includes seconds and
+// only the necessary fractional second digits.
+func TimeOfDayTime(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+
+	return TimeOfDay{
+		stringValue: stringValue{
+			valueString: t.Format("15:04:05.999999999"),
+		},
+		hour:       hour,
+		minute:     minute,
+		second:     second,
+		nanosecond: t.Nanosecond(),
+	}
+}
+
+// TimeOfDayUnknown returns an unknown TimeOfDay.
+func TimeOfDayUnknown() TimeOfDay {
+	return TimeOfDay{
+		stringValue: stringValue{
+			unknown: true,
+		},
+	}
+}
+
+// TimeOfDay implements the attr.Value interface for usage in logic. It
+// represents a wall clock time without any date or time zone.
+type TimeOfDay struct {
+	stringValue
+
+	hour       int
+	minute     int
+	second     int
+	nanosecond int
+}
+
+// After returns true if the TimeOfDay is later in the day than the given
+// TimeOfDay.
+func (v TimeOfDay) After(o TimeOfDay) bool {
+	return v.Compare(o) > 0
+}
+
+// Before returns true if the TimeOfDay is earlier in the day than the given
+// TimeOfDay.
+func (v TimeOfDay) Before(o TimeOfDay) bool {
+	return v.Compare(o) < 0
+}
+
+// Compare returns -1 if the TimeOfDay is earlier in the day than the given
+// TimeOfDay, +1 if it is later, or 0 if they represent the same wall clock
+// time.
+func (v TimeOfDay) Compare(o TimeOfDay) int {
+	switch d, od := v.sinceMidnight(), o.sinceMidnight(); {
+	case d < od:
+		return -1
+	case d > od:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a TimeOfDay type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented wall clock times
+// instead.
+func (v TimeOfDay) Equal(o attr.Value) bool {
+	otherValue, ok := o.(TimeOfDay)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// Hour returns the hour of a TimeOfDay, in the range 0-23.
+func (v TimeOfDay) Hour() int {
+	return v.hour
+}
+
+// Minute returns the minute of a TimeOfDay, in the range 0-59.
+func (v TimeOfDay) Minute() int {
+	return v.minute
+}
+
+// Nanosecond returns the nanosecond offset within the second of a
+// TimeOfDay, in the range 0-999999999.
+func (v TimeOfDay) Nanosecond() int {
+	return v.nanosecond
+}
+
+// Second returns the second of a TimeOfDay, in the range 0-59.
+func (v TimeOfDay) Second() int {
+	return v.second
+}
+
+// StringSemanticEquals returns true if the given TimeOfDay represents the
+// same wall clock time, regardless of omitted seconds or fractional second
+// digits in the string representation. The framework calls this method to
+// keep the prior value and prevent unexpected differences.
+func (v TimeOfDay) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimeOfDay)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.Compare(newValue) == 0, diags
+}
+
+// Type returns the attr.Type of TimeOfDay.
+func (v TimeOfDay) Type(_ context.Context) attr.Type {
+	return TimeOfDayType{}
+}
+
+// sinceMidnight returns the duration of the TimeOfDay since midnight.
+func (v TimeOfDay) sinceMidnight() time.Duration {
+	return time.Duration(v.hour)*time.Hour +
+		time.Duration(v.minute)*time.Minute +
+		time.Duration(v.second)*time.Second +
+		time.Duration(v.nanosecond)
+}
@@ -0,0 +1,643 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTimeOfDayAfter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		other    timetypes.TimeOfDay
+		expected bool
+	}{
+		"earlier": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:30:01"),
+			expected: false,
+		},
+		"equal": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:30:00"),
+			expected: false,
+		},
+		"later": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30:00.000000001"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.After(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayBefore(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		other    timetypes.TimeOfDay
+		expected bool
+	}{
+		"earlier": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:30:01"),
+			expected: true,
+		},
+		"equal": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:30:00"),
+			expected: false,
+		},
+		"later": {
+			value:    testValue(t, timetypes.TimeOfDayString, "23:00"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Before(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayCompare(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		other    timetypes.TimeOfDay
+		expected int
+	}{
+		"earlier-hour": {
+			value:    testValue(t, timetypes.TimeOfDayString, "02:59:59"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:00"),
+			expected: -1,
+		},
+		"earlier-nanosecond": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:00:00.1"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:00:00.100000001"),
+			expected: -1,
+		},
+		"equal": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:00"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:00:00.000"),
+			expected: 0,
+		},
+		"later-minute": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:01"),
+			other:    testValue(t, timetypes.TimeOfDayString, "03:00:59.999"),
+			expected: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Compare(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.TimeOfDayNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.TimeOfDay": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			other:    types.StringValue("15:04:05"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.TimeOfDayNull(),
+			other:    timetypes.TimeOfDayNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.TimeOfDayNull(),
+			other:    timetypes.TimeOfDayUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.TimeOfDayNull(),
+			other:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.TimeOfDayUnknown(),
+			other:    timetypes.TimeOfDayNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.TimeOfDayUnknown(),
+			other:    timetypes.TimeOfDayUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.TimeOfDayUnknown(),
+			other:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			other:    timetypes.TimeOfDayNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			other:    timetypes.TimeOfDayUnknown(),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			other:    testValue(t, timetypes.TimeOfDayString, "16:05:06"),
+			expected: false,
+		},
+		"value-value-different-string-same-time": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04"),
+			other:    testValue(t, timetypes.TimeOfDayString, "15:04:00"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			other:    timetypes.TimeOfDayTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayHourMinuteSecondNanosecond(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value              timetypes.TimeOfDay
+		expectedHour       int
+		expectedMinute     int
+		expectedSecond     int
+		expectedNanosecond int
+	}{
+		"null": {
+			value: timetypes.TimeOfDayNull(),
+		},
+		"unknown": {
+			value: timetypes.TimeOfDayUnknown(),
+		},
+		"value-hour-minute": {
+			value:          testValue(t, timetypes.TimeOfDayString, "03:30"),
+			expectedHour:   3,
+			expectedMinute: 30,
+		},
+		"value-hour-minute-second": {
+			value:          testValue(t, timetypes.TimeOfDayString, "03:30:15"),
+			expectedHour:   3,
+			expectedMinute: 30,
+			expectedSecond: 15,
+		},
+		"value-fractional-seconds": {
+			value:              testValue(t, timetypes.TimeOfDayString, "23:59:59.25"),
+			expectedHour:       23,
+			expectedMinute:     59,
+			expectedSecond:     59,
+			expectedNanosecond: 250000000,
+		},
+		"value-time": {
+			value:              timetypes.TimeOfDayTime(time.Date(2006, 1, 2, 15, 4, 5, 123, time.FixedZone("", 7*60*60))),
+			expectedHour:       15,
+			expectedMinute:     4,
+			expectedSecond:     5,
+			expectedNanosecond: 123,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.value.Hour(), testCase.expectedHour); diff != "" {
+				t.Errorf("unexpected hour difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Minute(), testCase.expectedMinute); diff != "" {
+				t.Errorf("unexpected minute difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Second(), testCase.expectedSecond); diff != "" {
+				t.Errorf("unexpected second difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.value.Nanosecond(), testCase.expectedNanosecond); diff != "" {
+				t.Errorf("unexpected nanosecond difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.TimeOfDayNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.TimeOfDayUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.TimeOfDayNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.TimeOfDayUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.TimeOfDay
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.TimeOfDay": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			newValue: types.StringValue("15:04:05"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.TimeOfDay\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			newValue: testValue(t, timetypes.TimeOfDayString, "15:04:06"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			newValue: testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected: true,
+		},
+		"value-value-fractional-seconds": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04:05.5"),
+			newValue: testValue(t, timetypes.TimeOfDayString, "15:04:05.500"),
+			expected: true,
+		},
+		"value-value-seconds-omitted": {
+			value:    testValue(t, timetypes.TimeOfDayString, "15:04"),
+			newValue: testValue(t, timetypes.TimeOfDayString, "15:04:00"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		expected string
+	}{
+		"null": {
+			value:    timetypes.TimeOfDayNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.TimeOfDayUnknown(),
+			expected: "<unknown>",
+		},
+		"value-string-hour-minute": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			expected: "\"03:30\"",
+		},
+		"value-time": {
+			value:    timetypes.TimeOfDayTime(time.Date(2006, 1, 2, 3, 30, 0, 0, time.UTC)),
+			expected: "\"03:30:00\"",
+		},
+		"value-time-fractional-seconds": {
+			value:    timetypes.TimeOfDayTime(time.Date(2006, 1, 2, 3, 30, 0, 120000000, time.UTC)),
+			expected: "\"03:30:00.12\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.TimeOfDay
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.TimeOfDayNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.TimeOfDayUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			expected: types.StringValue("03:30"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.TimeOfDay
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.TimeOfDayNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.TimeOfDayUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value-string": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+			expected: tftypes.NewValue(tftypes.String, "03:30"),
+		},
+		"value-time": {
+			value:    timetypes.TimeOfDayTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			expected: tftypes.NewValue(tftypes.String, "15:04:05"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.TimeOfDayNull(),
+			expected: timetypes.TimeOfDayType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeOfDay
+		expected string
+	}{
+		"null": {
+			value:    timetypes.TimeOfDayNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.TimeOfDayUnknown(),
+			expected: "",
+		},
+		"value-string": {
+			value:    testValue(t, timetypes.TimeOfDayString, "03:30:00.500"),
+			expected: "03:30:00.500",
+		},
+		"value-time": {
+			value:    timetypes.TimeOfDayTime(time.Date(2006, 1, 2, 3, 30, 0, 500000000, time.UTC)),
+			expected: "03:30:00.5",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = TimeOfDayType{}
+	_ attr.Type                    = TimeOfDayType{}
+	_ basetypes.StringTypable      = TimeOfDayType{}
+	_ xattr.TypeWithValidate       = TimeOfDayType{}
+)
+
+// TimeOfDayType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are RFC 3339 partial-time strings, such
+// as 15:04:05 or 15:04:05.999, where seconds may be omitted, such as 15:04.
+type TimeOfDayType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t TimeOfDayType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is TimeOfDayType.
+func (t TimeOfDayType) Equal(o attr.Type) bool {
+	_, ok := o.(TimeOfDayType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t TimeOfDayType) String() string {
+	return "timetypes.TimeOfDayType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t TimeOfDayType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always RFC 3339 partial-time conformant.
+func (t TimeOfDayType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid Time of Day Terraform Value",
+				"An unexpected error occurred while attempting to read a time of day string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := TimeOfDayString(str, schemaPath)
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t TimeOfDayType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return TimeOfDayNull(), nil
+	}
+
+	if in.IsUnknown() {
+		return TimeOfDayUnknown(), nil
+	}
+
+	return TimeOfDayString(in.ValueString(), path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t TimeOfDayType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return TimeOfDayNull(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return TimeOfDayUnknown(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return TimeOfDayUnknown(), err
+	}
+
+	hour, minute, second, nanosecond, err := parseTimeOfDay(str)
+
+	if err != nil {
+		return TimeOfDayUnknown(), err
+	}
+
+	return TimeOfDay{
+		stringValue: stringValue{
+			valueString: str,
+		},
+		hour:       hour,
+		minute:     minute,
+		second:     second,
+		nanosecond: nanosecond,
+	}, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t TimeOfDayType) ValueType(_ context.Context) attr.Value {
+	return TimeOfDay{}
+}
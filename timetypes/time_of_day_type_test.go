@@ -0,0 +1,525 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTimeOfDayTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.TimeOfDayType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.TimeOfDayType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.TimeOfDayType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.TimeOfDayType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.TimeOfDayType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.TimeOfDayType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.TimeOfDayType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.TimeOfDayType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.TimeOfDayType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.TimeOfDayType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.TimeOfDayType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.TimeOfDayType": {
+			typ:      timetypes.TimeOfDayType{},
+			other:    timetypes.TimeOfDayType{},
+			expected: true,
+		},
+		"timetypes.DateType": {
+			typ:      timetypes.TimeOfDayType{},
+			other:    timetypes.DateType{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.TimeOfDayType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.TimeOfDayType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.TimeOfDayType{},
+			expected: "timetypes.TimeOfDayType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.TimeOfDayType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.TimeOfDayType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.TimeOfDayType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day Terraform Value",
+					"An unexpected error occurred while attempting to read a time of day string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-time-format"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						"Invalid time-hour at character 1, expected 2 digits:\n\n"+
+						"    not-time-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-leap-second": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "23:59:60"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						"Invalid time-second at character 7, expected 00-59:\n\n"+
+						"    23:59:60\n"+
+						"          ^",
+				),
+			},
+		},
+		"string-value-invalid-leap-second-fractional": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "23:59:60.5"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						"Invalid time-second at character 7, expected 00-59:\n\n"+
+						"    23:59:60.5\n"+
+						"          ^",
+				),
+			},
+		},
+		"string-value-invalid-offset": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "15:04:05Z"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						"Invalid partial-time at character 9, expected \".\" or end of string:\n\n"+
+						"    15:04:05Z\n"+
+						"            ^",
+				),
+			},
+		},
+		"string-value-invalid-seconds-separator": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "15:04.05"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						"Invalid partial-time at character 6, expected \":\" or end of string:\n\n"+
+						"    15:04.05\n"+
+						"         ^",
+				),
+			},
+		},
+		"string-value-invalid-time-hour": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "24:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						"Invalid time-hour at character 1, expected 00-23:\n\n"+
+						"    24:00\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-time-minute": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "03:60"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						"Invalid time-minute at character 4, expected 00-59:\n\n"+
+						"    03:60\n"+
+						"       ^",
+				),
+			},
+		},
+		"string-value-valid-fractional-seconds": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "03:30:00.123456789"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-hour-minute": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "03:30"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-hour-minute-second": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "03:30:00"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.TimeOfDayType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.TimeOfDayType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.TimeOfDayNull(),
+		},
+		"unknown": {
+			typ:         timetypes.TimeOfDayType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.TimeOfDayUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.TimeOfDayType{},
+			stringValue: types.StringValue("not-time-format"),
+			expected:    timetypes.TimeOfDayUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Time of Day String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 partial-time format. "+
+						"The RFC 3339 partial-time string format is HH:MM:SS with optional fractional seconds, such as 15:04:05 or 15:04:05.999. "+
+						"Seconds may also be omitted, such as 15:04.\n\n"+
+						"Invalid time-hour at character 1, expected 2 digits:\n\n"+
+						"    not-time-format\n"+
+						"    ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.TimeOfDayType{},
+			stringValue: types.StringValue("03:30"),
+			expected:    testValue(t, timetypes.TimeOfDayString, "03:30"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.TimeOfDayType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.TimeOfDayUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.TimeOfDayNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.TimeOfDayUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "not-time-format"),
+			expected:       timetypes.TimeOfDayUnknown(),
+			expectedError:  fmt.Errorf("parsing \"not-time-format\" as RFC 3339 partial-time: invalid time-hour at offset 0: expected 2 digits"),
+		},
+		"string-value-valid-hour-minute": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "03:30"),
+			expected:       testValue(t, timetypes.TimeOfDayString, "03:30"),
+		},
+		"string-value-valid-hour-minute-second": {
+			typ:            timetypes.TimeOfDayType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "15:04:05"),
+			expected:       timetypes.TimeOfDayTime(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.TimeOfDayType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.TimeOfDayType{},
+			expected: timetypes.TimeOfDay{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
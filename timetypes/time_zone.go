@@ -0,0 +1,235 @@
+package timetypes
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = TimeZone{}
+	_ basetypes.StringValuable                   = TimeZone{}
+	_ basetypes.StringValuableWithSemanticEquals = TimeZone{}
+)
+
+// TimeZoneNull returns a null TimeZone.
+func TimeZoneNull() TimeZone {
+	return TimeZone{
+		stringValue: stringValue{
+			null: true,
+		},
+	}
+}
+
+// TimeZoneString returns a known TimeZone or any errors while attempting to
+// load the string as an IANA time zone name.
+func TimeZoneString(s string, schemaPath path.Path) (TimeZone, diag.Diagnostics) {
+	loc, err := loadTimeZone(s)
+
+	if err != nil {
+		return TimeZone{
+				stringValue: stringValue{
+					unknown: true,
+				},
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be an IANA time zone name. "+
+						"The IANA time zone name format is a name from the IANA time zone database, such as America/New_York or Europe/Paris.\n\n"+
+						"Error: "+err.Error(),
+				),
+			}
+	}
+
+	return TimeZone{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		value: loc,
+	}, nil
+}
+
+// TimeZoneUnknown returns an unknown TimeZone.
+func TimeZoneUnknown() TimeZone {
+	return TimeZone{
+		stringValue: stringValue{
+			unknown: true,
+		},
+	}
+}
+
+// TimeZone implements the attr.Value interface for usage in logic.
+type TimeZone struct {
+	stringValue
+
+	value *time.Location
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a TimeZone type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to also consider deprecated names equal to their
+// preferred names.
+func (v TimeZone) Equal(o attr.Value) bool {
+	otherValue, ok := o.(TimeZone)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// IsDeprecated returns true if the TimeZone is a deprecated name in the IANA
+// time zone database, such as US/Eastern.
+func (v TimeZone) IsDeprecated() bool {
+	_, ok := timeZoneDeprecatedNames[v.valueString]
+
+	return ok
+}
+
+// Location returns the *time.Location of a TimeZone. A nil *time.Location is
+// returned for a null or unknown TimeZone.
+func (v TimeZone) Location() *time.Location {
+	return v.value
+}
+
+// PreferredName returns the preferred IANA time zone name of a known
+// TimeZone, such as America/New_York for US/Eastern. The original string
+// representation is returned unless it is a deprecated name.
+func (v TimeZone) PreferredName() string {
+	return timeZonePreferredName(v.valueString)
+}
+
+// StringSemanticEquals returns true if the given TimeZone has the same
+// preferred name, such as US/Eastern and America/New_York. The framework
+// calls this method to keep the prior value and prevent unexpected
+// differences.
+func (v TimeZone) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimeZone)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.PreferredName() == newValue.PreferredName(), diags
+}
+
+// Type returns the attr.Type of TimeZone.
+func (v TimeZone) Type(_ context.Context) attr.Type {
+	return TimeZoneType{}
+}
+
+// loadTimeZone returns the *time.Location of the IANA time zone name. Unlike
+// time.LoadLocation, the empty name and Local are not accepted, since they
+// are not IANA time zone names and Local depends on the host, and the name
+// must match the letter case of the time zone database.
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, errors.New("empty time zone name")
+	}
+
+	if name == "Local" {
+		return nil, errors.New("unknown time zone Local")
+	}
+
+	loc, err := time.LoadLocation(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !timeZoneNameMatchesCase(name) {
+		return nil, errors.New("unknown time zone " + name)
+	}
+
+	return loc, nil
+}
+
+// timeZoneDirs are the host time zone database directories searched by
+// time.LoadLocation after the ZONEINFO environment variable.
+var timeZoneDirs = []string{
+	"/usr/share/zoneinfo/",
+	"/usr/share/lib/zoneinfo/",
+	"/usr/lib/locale/TZ/",
+	"/etc/zoneinfo/",
+}
+
+// timeZoneNameMatchesCase returns false if the first host time zone database
+// directory containing the time zone name only contains it with differing
+// letter case, which time.LoadLocation accepts on case-insensitive file
+// systems, such as on macOS and Windows. The embedded time/tzdata and Go
+// installation zoneinfo.zip databases are always case-sensitive.
+func timeZoneNameMatchesCase(name string) bool {
+	dirs := timeZoneDirs
+
+	if dir := os.Getenv("ZONEINFO"); dir != "" {
+		dirs = append([]string{dir}, dirs...)
+	}
+
+	for _, dir := range dirs {
+		matches, found := timeZoneDirMatchesCase(dir, name)
+
+		if found {
+			return matches
+		}
+	}
+
+	return true
+}
+
+// timeZoneDirMatchesCase returns whether the time zone name is found in the
+// time zone database directory, ignoring letter case, and whether each
+// element of the name matches the letter case of the directory entries.
+func timeZoneDirMatchesCase(dir string, name string) (matches, found bool) {
+	matches = true
+
+	for _, element := range strings.Split(name, "/") {
+		entries, err := os.ReadDir(dir)
+
+		if err != nil {
+			return false, false
+		}
+
+		var next string
+
+		for _, entry := range entries {
+			if entry.Name() == element {
+				next = element
+
+				break
+			}
+
+			if next == "" && strings.EqualFold(entry.Name(), element) {
+				next = entry.Name()
+			}
+		}
+
+		if next == "" {
+			return false, false
+		}
+
+		if next != element {
+			matches = false
+		}
+
+		dir = filepath.Join(dir, next)
+	}
+
+	return matches, true
+}
@@ -0,0 +1,603 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTimeZoneEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeZone
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.TimeZoneNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.TimeZone": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			other:    types.StringValue("America/New_York"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.TimeZoneNull(),
+			other:    timetypes.TimeZoneNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.TimeZoneNull(),
+			other:    timetypes.TimeZoneUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.TimeZoneNull(),
+			other:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.TimeZoneUnknown(),
+			other:    timetypes.TimeZoneNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			other:    timetypes.TimeZoneUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.TimeZoneUnknown(),
+			other:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			other:    timetypes.TimeZoneNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			other:    timetypes.TimeZoneUnknown(),
+			expected: false,
+		},
+		"value-value-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "US/Eastern"),
+			other:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			other:    testValue(t, timetypes.TimeZoneString, "America/Chicago"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			other:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneIsDeprecated(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeZone
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: false,
+		},
+		"value-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "US/Eastern"),
+			expected: true,
+		},
+		"value-deprecated-renamed": {
+			value:    testValue(t, timetypes.TimeZoneString, "Asia/Calcutta"),
+			expected: true,
+		},
+		"value-utc": {
+			value:    testValue(t, timetypes.TimeZoneString, "UTC"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsDeprecated()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeZone
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeZone
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value          timetypes.TimeZone
+		expectedName   string
+		expectedOffset int
+	}{
+		"null": {
+			value: timetypes.TimeZoneNull(),
+		},
+		"unknown": {
+			value: timetypes.TimeZoneUnknown(),
+		},
+		"value": {
+			value:          testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expectedName:   "America/New_York",
+			expectedOffset: -5 * 60 * 60,
+		},
+		"value-deprecated": {
+			value:          testValue(t, timetypes.TimeZoneString, "Asia/Calcutta"),
+			expectedName:   "Asia/Calcutta",
+			expectedOffset: 5*60*60 + 30*60,
+		},
+		"value-utc": {
+			value:          testValue(t, timetypes.TimeZoneString, "UTC"),
+			expectedName:   "UTC",
+			expectedOffset: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Location()
+
+			if testCase.expectedName == "" {
+				if got != nil {
+					t.Fatalf("expected no location, got: %s", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected location %s, got none", testCase.expectedName)
+			}
+
+			if diff := cmp.Diff(got.String(), testCase.expectedName); diff != "" {
+				t.Errorf("unexpected name difference: %s", diff)
+			}
+
+			_, offset := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC).In(got).Zone()
+
+			if diff := cmp.Diff(offset, testCase.expectedOffset); diff != "" {
+				t.Errorf("unexpected offset difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZonePreferredName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeZone
+		expected string
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: "America/New_York",
+		},
+		"value-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "US/Eastern"),
+			expected: "America/New_York",
+		},
+		"value-deprecated-renamed": {
+			value:    testValue(t, timetypes.TimeZoneString, "Europe/Kiev"),
+			expected: "Europe/Kyiv",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.PreferredName()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.TimeZone
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.TimeZone": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			newValue: types.StringValue("America/New_York"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.TimeZone\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "US/Eastern"),
+			newValue: testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: true,
+		},
+		"value-value-deprecated-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "Asia/Calcutta"),
+			newValue: testValue(t, timetypes.TimeZoneString, "Asia/Calcutta"),
+			expected: true,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			newValue: testValue(t, timetypes.TimeZoneString, "America/Detroit"),
+			expected: false,
+		},
+		"value-value-different-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "US/Central"),
+			newValue: testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			newValue: testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeZone
+		expected string
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: "\"America/New_York\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.TimeZone
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: types.StringValue("America/New_York"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.TimeZone
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/New_York"),
+			expected: tftypes.NewValue(tftypes.String, "America/New_York"),
+		},
+		"value-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "US/Eastern"),
+			expected: tftypes.NewValue(tftypes.String, "US/Eastern"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeZone
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.TimeZoneNull(),
+			expected: timetypes.TimeZoneType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.TimeZone
+		expected string
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "Europe/Paris"),
+			expected: "Europe/Paris",
+		},
+		"value-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "Europe/Kiev"),
+			expected: "Europe/Kiev",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
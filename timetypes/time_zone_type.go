@@ -0,0 +1,146 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = TimeZoneType{}
+	_ attr.Type                    = TimeZoneType{}
+	_ basetypes.StringTypable      = TimeZoneType{}
+	_ xattr.TypeWithValidate       = TimeZoneType{}
+)
+
+// TimeZoneType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are IANA time zone names, as accepted
+// by time.LoadLocation, such as America/New_York or Europe/Paris. Names must
+// match the letter case of the time zone database, including on hosts with
+// case-insensitive file systems.
+//
+// Time zones are loaded from the host time zone database. Import the tzdata
+// package of this module to embed a copy of the database in the provider,
+// which is used if the host time zone database is unavailable.
+type TimeZoneType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t TimeZoneType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is TimeZoneType.
+func (t TimeZoneType) Equal(o attr.Type) bool {
+	_, ok := o.(TimeZoneType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t TimeZoneType) String() string {
+	return "timetypes.TimeZoneType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t TimeZoneType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always a known IANA time zone name. A
+// warning is raised for deprecated names, such as US/Eastern.
+func (t TimeZoneType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid Time Zone Terraform Value",
+				"An unexpected error occurred while attempting to read a time zone string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	value, diags := TimeZoneString(str, schemaPath)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if value.IsDeprecated() {
+		diags.AddAttributeWarning(
+			schemaPath,
+			"Deprecated Time Zone Name",
+			"The time zone name "+str+" is deprecated in the IANA time zone database and only kept for compatibility. "+
+				"Use "+value.PreferredName()+" instead.",
+		)
+	}
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t TimeZoneType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return TimeZoneNull(), nil
+	}
+
+	if in.IsUnknown() {
+		return TimeZoneUnknown(), nil
+	}
+
+	return TimeZoneString(in.ValueString(), path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t TimeZoneType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return TimeZoneNull(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return TimeZoneUnknown(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return TimeZoneUnknown(), err
+	}
+
+	loc, err := loadTimeZone(str)
+
+	if err != nil {
+		return TimeZoneUnknown(), err
+	}
+
+	return TimeZone{
+		stringValue: stringValue{
+			valueString: str,
+		},
+		value: loc,
+	}, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t TimeZoneType) ValueType(_ context.Context) attr.Value {
+	return TimeZone{}
+}
@@ -0,0 +1,482 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTimeZoneTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.TimeZoneType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.TimeZoneType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.TimeZoneType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.TimeZoneType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.TimeZoneType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.TimeZoneType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.TimeZoneType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.TimeZoneType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.TimeZoneType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.TimeZoneType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.TimeZoneType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.TimeZoneType": {
+			typ:      timetypes.TimeZoneType{},
+			other:    timetypes.TimeZoneType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.TimeZoneType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.TimeZoneType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.TimeZoneType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.TimeZoneType{},
+			expected: "timetypes.TimeZoneType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.TimeZoneType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.TimeZoneType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.TimeZoneType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone Terraform Value",
+					"An unexpected error occurred while attempting to read a time zone string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-deprecated": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "US/Eastern"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"Deprecated Time Zone Name",
+					"The time zone name US/Eastern is deprecated in the IANA time zone database and only kept for compatibility. "+
+						"Use America/New_York instead.",
+				),
+			},
+		},
+		"string-value-empty": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, ""),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be an IANA time zone name. "+
+						"The IANA time zone name format is a name from the IANA time zone database, such as America/New_York or Europe/Paris.\n\n"+
+						"Error: empty time zone name",
+				),
+			},
+		},
+		"string-value-invalid": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "America/Nowhere"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be an IANA time zone name. "+
+						"The IANA time zone name format is a name from the IANA time zone database, such as America/New_York or Europe/Paris.\n\n"+
+						"Error: unknown time zone America/Nowhere",
+				),
+			},
+		},
+		"string-value-letter-case": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "america/new_york"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be an IANA time zone name. "+
+						"The IANA time zone name format is a name from the IANA time zone database, such as America/New_York or Europe/Paris.\n\n"+
+						"Error: unknown time zone america/new_york",
+				),
+			},
+		},
+		"string-value-local": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Local"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be an IANA time zone name. "+
+						"The IANA time zone name format is a name from the IANA time zone database, such as America/New_York or Europe/Paris.\n\n"+
+						"Error: unknown time zone Local",
+				),
+			},
+		},
+		"string-value-valid": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "America/New_York"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-etc": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Etc/GMT+5"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-utc": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "UTC"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.TimeZoneType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.TimeZoneType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.TimeZoneNull(),
+		},
+		"unknown": {
+			typ:         timetypes.TimeZoneType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.TimeZoneUnknown(),
+		},
+		"value-deprecated": {
+			typ:         timetypes.TimeZoneType{},
+			stringValue: types.StringValue("US/Eastern"),
+			expected:    testValue(t, timetypes.TimeZoneString, "US/Eastern"),
+		},
+		"value-invalid": {
+			typ:         timetypes.TimeZoneType{},
+			stringValue: types.StringValue("America/Nowhere"),
+			expected:    timetypes.TimeZoneUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be an IANA time zone name. "+
+						"The IANA time zone name format is a name from the IANA time zone database, such as America/New_York or Europe/Paris.\n\n"+
+						"Error: unknown time zone America/Nowhere",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.TimeZoneType{},
+			stringValue: types.StringValue("Europe/Paris"),
+			expected:    testValue(t, timetypes.TimeZoneString, "Europe/Paris"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.TimeZoneType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.TimeZoneUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.TimeZoneNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.TimeZoneUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "America/Nowhere"),
+			expected:       timetypes.TimeZoneUnknown(),
+			expectedError:  fmt.Errorf("unknown time zone America/Nowhere"),
+		},
+		"string-value-letter-case": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "america/new_york"),
+			expected:       timetypes.TimeZoneUnknown(),
+			expectedError:  fmt.Errorf("unknown time zone america/new_york"),
+		},
+		"string-value-valid": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "America/New_York"),
+			expected:       testValue(t, timetypes.TimeZoneString, "America/New_York"),
+		},
+		"string-value-valid-deprecated": {
+			typ:            timetypes.TimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "US/Eastern"),
+			expected:       testValue(t, timetypes.TimeZoneString, "US/Eastern"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeZoneTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.TimeZoneType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.TimeZoneType{},
+			expected: timetypes.TimeZone{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
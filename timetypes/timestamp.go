@@ -0,0 +1,156 @@
+package timetypes
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// timestamp is the shared implementation of timestamp string values, such as
+// RFC3339. Value types embed it and implement the methods which depend on
+// the concrete type, such as Equal, StringSemanticEquals, and Type.
+type timestamp struct {
+	stringValue
+
+	value time.Time
+}
+
+// timestampString returns a known timestamp or any errors while attempting
+// to parse the string in the format.
+func timestampString(f timestampFormat, s string, schemaPath path.Path) (timestamp, diag.Diagnostics) {
+	t, err := f.parse(s)
+
+	if err != nil {
+		errDetail := parseErrorDetail(err)
+
+		if fs, ok := f.(timestampFormatWithSuggestion); ok {
+			if suggestion := fs.suggestion(s); suggestion != "" {
+				errDetail += "\n\nDid you mean " + suggestion + "?"
+			}
+		}
+
+		return timestamp{
+			stringValue: stringValue{
+				unknown: true,
+			},
+		}, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid "+f.name()+" String Value",
+				"An unexpected error occurred while converting a string value that was expected to be "+f.name()+" format. "+
+					f.describe()+"\n\n"+
+					errDetail,
+			),
+		}
+	}
+
+	return timestamp{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		value: t,
+	}, nil
+}
+
+// timestampTime returns a known timestamp with the given time, formatted in
+// the format.
+func timestampTime(f timestampFormat, t time.Time) timestamp {
+	value, valueString := f.format(t)
+
+	return timestamp{
+		stringValue: stringValue{
+			valueString: valueString,
+		},
+		value: value,
+	}
+}
+
+// timestampValidate ensures the Terraform value is conformant to the format.
+func timestampValidate(f timestampFormat, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid "+f.name()+" Terraform Value",
+				"An unexpected error occurred while attempting to read a "+f.name()+" string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	value, diags := timestampString(f, str, schemaPath)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if fv, ok := f.(timestampFormatWithValidate); ok {
+		diags.Append(fv.validate(value, schemaPath)...)
+	}
+
+	return diags
+}
+
+// timestampValueFromString converts the types.String into a timestamp in the
+// format.
+func timestampValueFromString(f timestampFormat, in basetypes.StringValue) (timestamp, diag.Diagnostics) {
+	if in.IsNull() {
+		return timestamp{stringValue: stringValue{null: true}}, nil
+	}
+
+	if in.IsUnknown() {
+		return timestamp{stringValue: stringValue{unknown: true}}, nil
+	}
+
+	return timestampString(f, in.ValueString(), path.Empty())
+}
+
+// timestampValueFromTerraform converts the tftypes.Value into a timestamp in
+// the format. An unknown timestamp is returned with any error.
+func timestampValueFromTerraform(f timestampFormat, terraformValue tftypes.Value) (timestamp, error) {
+	if terraformValue.IsNull() {
+		return timestamp{stringValue: stringValue{null: true}}, nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return timestamp{stringValue: stringValue{unknown: true}}, nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return timestamp{stringValue: stringValue{unknown: true}}, err
+	}
+
+	t, err := f.parse(str)
+
+	if err != nil {
+		return timestamp{stringValue: stringValue{unknown: true}}, err
+	}
+
+	return timestamp{
+		stringValue: stringValue{
+			valueString: str,
+		},
+		value: t,
+	}, nil
+}
+
+// Time returns the time.Time of the value.
+func (v timestamp) Time() time.Time {
+	return v.value
+}
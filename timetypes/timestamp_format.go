@@ -0,0 +1,52 @@
+package timetypes
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// timestampFormat defines a timestamp string format for the shared timestamp
+// value and type implementation. Adding a new format only requires a
+// timestampFormat implementation, usually the schema type itself, and thin
+// exported type and value wrappers.
+type timestampFormat interface {
+	// describe returns a practitioner friendly description of the string
+	// format with an example, such as "The RFC 3339 string format is
+	// YYYY-MM-DDTHH:MM:SSZ, such as 2006-01-02T15:04:05Z.", which is used in
+	// diagnostics.
+	describe() string
+
+	// format returns the time and string representation of the given time,
+	// which may lose information not included in the format, such as
+	// fractional seconds.
+	format(t time.Time) (time.Time, string)
+
+	// name returns the human readable name of the format, such as RFC 3339,
+	// which is used in diagnostics.
+	name() string
+
+	// parse returns the time of the string or an error. Errors should be a
+	// *ParseError, so diagnostics can point at the invalid character.
+	parse(s string) (time.Time, error)
+}
+
+// timestampFormatWithSuggestion is a timestampFormat which can suggest a
+// corrected string for invalid strings.
+type timestampFormatWithSuggestion interface {
+	timestampFormat
+
+	// suggestion returns a corrected string for the invalid string or an
+	// empty string if there is no valid suggestion.
+	suggestion(s string) string
+}
+
+// timestampFormatWithValidate is a timestampFormat which has additional
+// validation for successfully parsed strings, such as format options.
+type timestampFormatWithValidate interface {
+	timestampFormat
+
+	// validate returns any diagnostics for the parsed timestamp.
+	validate(value timestamp, schemaPath path.Path) diag.Diagnostics
+}
@@ -0,0 +1,14 @@
+// package tzdata embeds a copy of the IANA time zone database in the
+// program, which is used by timetypes.TimeZoneType when the host time zone
+// database is unavailable, such as in minimal container images or on
+// Windows. Import it for its side effect only:
+//
+//	import _ "github.com/bflad/terraform-plugin-framework-type-time/timetypes/tzdata"
+//
+// This adds about 450 KB to the provider binary. Building with the
+// timetzdata tag has the same effect for the whole program.
+package tzdata
+
+import (
+	_ "time/tzdata"
+)
@@ -0,0 +1,218 @@
+package timetypes
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value               = UnixTimestamp{}
+	_ basetypes.NumberValuable = UnixTimestamp{}
+)
+
+// UnixTimestampInt64 returns a known UnixTimestamp with the given number of
+// seconds since the Unix epoch. An error diagnostic is returned if the number
+// is outside the years 0000 to 9999. Use UnixTimestampType.ValueFromInt64
+// for other units.
+func UnixTimestampInt64(seconds int64) (UnixTimestamp, diag.Diagnostics) {
+	return UnixTimestampType{}.ValueFromInt64(seconds)
+}
+
+// UnixTimestampNull returns a null UnixTimestamp in seconds. Use
+// UnixTimestampType.NullValue for other units.
+func UnixTimestampNull() UnixTimestamp {
+	return UnixTimestamp{
+		null: true,
+	}
+}
+
+// UnixTimestampNumber returns a known UnixTimestamp or any errors while
+// attempting to convert the number to a whole number of seconds since the
+// Unix epoch. Use UnixTimestampType.ValueFromNumber for other units.
+func UnixTimestampNumber(f *big.Float, schemaPath path.Path) (UnixTimestamp, diag.Diagnostics) {
+	return unixTimestampNumber(f, UnixTimestampUnitSeconds, schemaPath)
+}
+
+// UnixTimestampTime returns a known UnixTimestamp with the given time in
+// seconds. Any fractional seconds are truncated. An error diagnostic is
+// returned if the time is outside the years 0000 to 9999. Use
+// UnixTimestampType.ValueFromTime for other units.
+func UnixTimestampTime(t time.Time) (UnixTimestamp, diag.Diagnostics) {
+	return UnixTimestampType{}.ValueFromTime(t)
+}
+
+// UnixTimestampUnknown returns an unknown UnixTimestamp in seconds. Use
+// UnixTimestampType.UnknownValue for other units.
+func UnixTimestampUnknown() UnixTimestamp {
+	return UnixTimestamp{
+		unknown: true,
+	}
+}
+
+// UnixTimestamp implements the attr.Value interface for usage in logic. It
+// represents a whole number of units since the Unix epoch,
+// 1970-01-01T00:00:00Z.
+type UnixTimestamp struct {
+	null    bool
+	unknown bool
+	unit    UnixTimestampUnit
+	value   int64
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a UnixTimestamp type
+//   - Has the same null, unknown, unit, and number data
+func (v UnixTimestamp) Equal(o attr.Value) bool {
+	otherValue, ok := o.(UnixTimestamp)
+
+	if !ok {
+		return false
+	}
+
+	if otherValue.null != v.null {
+		return false
+	}
+
+	if otherValue.unknown != v.unknown {
+		return false
+	}
+
+	if otherValue.unit != v.unit {
+		return false
+	}
+
+	return otherValue.value == v.value
+}
+
+// IsNull returns true if the UnixTimestamp represents a null Value.
+func (v UnixTimestamp) IsNull() bool {
+	return v.null
+}
+
+// IsUnknown returns true if the UnixTimestamp represents an unknown Value.
+func (v UnixTimestamp) IsUnknown() bool {
+	return v.unknown
+}
+
+// String returns a human readable string of the UnixTimestamp.
+func (v UnixTimestamp) String() string {
+	if v.null {
+		return attr.NullValueString
+	}
+
+	if v.unknown {
+		return attr.UnknownValueString
+	}
+
+	return strconv.FormatInt(v.value, 10)
+}
+
+// Time returns the time.Time of a known UnixTimestamp in UTC. The zero
+// time.Time is returned for a null or unknown UnixTimestamp.
+func (v UnixTimestamp) Time() time.Time {
+	if v.null || v.unknown {
+		return time.Time{}
+	}
+
+	return v.unit.toTime(v.value)
+}
+
+// ToNumberValue converts the UnixTimestamp to a types.Number.
+func (v UnixTimestamp) ToNumberValue(_ context.Context) (basetypes.NumberValue, diag.Diagnostics) {
+	if v.null {
+		return basetypes.NewNumberNull(), nil
+	}
+
+	if v.unknown {
+		return basetypes.NewNumberUnknown(), nil
+	}
+
+	return basetypes.NewNumberValue(new(big.Float).SetInt64(v.value)), nil
+}
+
+// ToRFC3339 converts the UnixTimestamp to an RFC3339 in UTC with only the
+// necessary fractional second digits. A null or unknown UnixTimestamp returns
+// a null or unknown RFC3339.
+func (v UnixTimestamp) ToRFC3339() (RFC3339, diag.Diagnostics) {
+	if v.null {
+		return RFC3339Null(), nil
+	}
+
+	if v.unknown {
+		return RFC3339Unknown(), nil
+	}
+
+	return v.unit.toRFC3339(v.value)
+}
+
+// ToTerraformValue converts the UnixTimestamp to a tftypes.Number.
+func (v UnixTimestamp) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	if v.null {
+		return tftypes.NewValue(tftypes.Number, nil), nil
+	}
+
+	if v.unknown {
+		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	}
+
+	return tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(v.value)), nil
+}
+
+// Type returns the attr.Type of UnixTimestamp.
+func (v UnixTimestamp) Type(_ context.Context) attr.Type {
+	return UnixTimestampType{
+		Unit: v.unit,
+	}
+}
+
+// ValueBigFloat returns the number of units since the Unix epoch of a known
+// UnixTimestamp as a *big.Float, which always represents the number exactly.
+// A nil *big.Float is returned for a null or unknown UnixTimestamp.
+func (v UnixTimestamp) ValueBigFloat() *big.Float {
+	if v.null || v.unknown {
+		return nil
+	}
+
+	return new(big.Float).SetInt64(v.value)
+}
+
+// ValueInt64 returns the number of units since the Unix epoch of a known
+// UnixTimestamp. Zero is returned for a null or unknown UnixTimestamp.
+func (v UnixTimestamp) ValueInt64() int64 {
+	return v.value
+}
+
+// unixTimestampNumber returns a known UnixTimestamp in the unit or any errors
+// while attempting to convert the number.
+func unixTimestampNumber(f *big.Float, unit UnixTimestampUnit, schemaPath path.Path) (UnixTimestamp, diag.Diagnostics) {
+	value, err := unit.fromBigFloat(f)
+
+	if err != nil {
+		return UnixTimestamp{
+				unknown: true,
+				unit:    unit,
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid Unix Timestamp Number Value",
+					"An unexpected error occurred while converting a number value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp is a whole number of "+unit.String()+" since 1970-01-01T00:00:00Z, such as "+unit.example()+".\n\n"+
+						"Error: "+err.Error(),
+				),
+			}
+	}
+
+	return UnixTimestamp{
+		unit:  unit,
+		value: value,
+	}, nil
+}
@@ -0,0 +1,665 @@
+package timetypes_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnixTimestampEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UnixTimestamp
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.UnixTimestampNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.UnixTimestamp": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			other:    types.Int64Value(1136214245),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.UnixTimestampNull(),
+			other:    timetypes.UnixTimestampNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.UnixTimestampNull(),
+			other:    timetypes.UnixTimestampUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.UnixTimestampNull(),
+			other:    testValueFrom(t, timetypes.UnixTimestampInt64, 0),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.UnixTimestampUnknown(),
+			other:    timetypes.UnixTimestampNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			other:    timetypes.UnixTimestampUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.UnixTimestampUnknown(),
+			other:    testValueFrom(t, timetypes.UnixTimestampInt64, 0),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 0),
+			other:    timetypes.UnixTimestampNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 0),
+			other:    timetypes.UnixTimestampUnknown(),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			other:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214246),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			other:    testValueFrom(t, timetypes.UnixTimestampTime, time.Date(2006, 1, 2, 15, 4, 5, 999, time.UTC)),
+			expected: true,
+		},
+		"value-value-different-unit": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			other:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}.ValueFromInt64, 1136214245),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		seconds       int64
+		expected      timetypes.UnixTimestamp
+		expectedDiags diag.Diagnostics
+	}{
+		"max-int64": {
+			seconds:  math.MaxInt64,
+			expected: timetypes.UnixTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Int64 Value",
+					"An unexpected error occurred while converting an int64 to a Unix timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: number 9223372036854775807 is outside the range of -62167219200 to 253402300799 seconds",
+				),
+			},
+		},
+		"min-int64": {
+			seconds:  math.MinInt64,
+			expected: timetypes.UnixTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Int64 Value",
+					"An unexpected error occurred while converting an int64 to a Unix timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: number -9223372036854775808 is outside the range of -62167219200 to 253402300799 seconds",
+				),
+			},
+		},
+		"value": {
+			seconds:  1136214245,
+			expected: testValueFrom(t, timetypes.UnixTimestampTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+		},
+		"year-0000": {
+			seconds:  -62167219200,
+			expected: testValueFrom(t, timetypes.UnixTimestampTime, time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		"year-9999": {
+			seconds:  253402300799,
+			expected: testValueFrom(t, timetypes.UnixTimestampTime, time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)),
+		},
+		"year-after-9999": {
+			seconds:  253402300800,
+			expected: timetypes.UnixTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Int64 Value",
+					"An unexpected error occurred while converting an int64 to a Unix timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: number 253402300800 is outside the range of -62167219200 to 253402300799 seconds",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.UnixTimestampInt64(testCase.seconds)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UnixTimestamp
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 0),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UnixTimestamp
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 0),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		number        *big.Float
+		expected      timetypes.UnixTimestamp
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			number:   big.NewFloat(1136214245),
+			expected: testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+		},
+		"year-after-9999": {
+			number:   big.NewFloat(1e18),
+			expected: timetypes.UnixTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Number Value",
+					"An unexpected error occurred while converting a number value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Error: number 1000000000000000000 is outside the range of -62167219200 to 253402300799 seconds",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.UnixTimestampNumber(testCase.number, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UnixTimestamp
+		expected string
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			expected: "1136214245",
+		},
+		"value-negative": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, -1),
+			expected: "-1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UnixTimestamp
+		expected time.Time
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: time.Time{},
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: time.Time{},
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"value-epoch": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 0),
+			expected: time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"value-negative": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, -1),
+			expected: time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		"value-time-offset": {
+			value:    testValueFrom(t, timetypes.UnixTimestampTime, time.Date(2006, 1, 2, 15, 4, 5, 999, time.FixedZone("", -7*60*60))),
+			expected: time.Date(2006, 1, 2, 22, 4, 5, 0, time.UTC),
+		},
+		"value-microseconds": {
+			value:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMicroseconds}.ValueFromInt64, 1136214245123456),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.UTC),
+		},
+		"value-milliseconds": {
+			value:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}.ValueFromInt64, 1136214245123),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC),
+		},
+		"value-nanoseconds": {
+			value:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds}.ValueFromInt64, 1136214245123456789),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Time()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampToNumberValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.UnixTimestamp
+		expected      basetypes.NumberValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: types.NumberNull(),
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: types.NumberUnknown(),
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			expected: types.NumberValue(big.NewFloat(1136214245)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToNumberValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampToRFC3339(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.UnixTimestamp
+		expected      timetypes.RFC3339
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05Z"),
+		},
+		"value-milliseconds": {
+			value:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}.ValueFromInt64, 1136214245120),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.12Z"),
+		},
+		"value-nanoseconds": {
+			value:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds}.ValueFromInt64, 1136214245123456789),
+			expected: testValue(t, timetypes.RFC3339String, "2006-01-02T15:04:05.123456789Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToRFC3339()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.UnixTimestamp
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: tftypes.NewValue(tftypes.Number, nil),
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			expected: tftypes.NewValue(tftypes.Number, big.NewFloat(1136214245)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UnixTimestamp
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: timetypes.UnixTimestampType{},
+		},
+		"milliseconds": {
+			value:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}.ValueFromInt64, 0),
+			expected: timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampValueBigFloat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UnixTimestamp
+		expected *big.Float
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: nil,
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: nil,
+		},
+		"value": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			expected: big.NewFloat(1136214245),
+		},
+		"value-nanoseconds-max": {
+			value:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds}.ValueFromInt64, math.MaxInt64),
+			expected: new(big.Float).SetInt64(math.MaxInt64),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueBigFloat()
+
+			if got == nil || testCase.expected == nil {
+				if got != testCase.expected {
+					t.Errorf("expected %v, got: %v", testCase.expected, got)
+				}
+
+				return
+			}
+
+			if got.Cmp(testCase.expected) != 0 {
+				t.Errorf("expected %s, got: %s", testCase.expected.Text('f', -1), got.Text('f', -1))
+			}
+		})
+	}
+}
+
+func TestUnixTimestampValueInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UnixTimestamp
+		expected int64
+	}{
+		"null": {
+			value:    timetypes.UnixTimestampNull(),
+			expected: 0,
+		},
+		"unknown": {
+			value:    timetypes.UnixTimestampUnknown(),
+			expected: 0,
+		},
+		"value-int64": {
+			value:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+			expected: 1136214245,
+		},
+		"value-time": {
+			value:    testValueFrom(t, timetypes.UnixTimestampTime, time.Date(2006, 1, 2, 15, 4, 5, 999, time.UTC)),
+			expected: 1136214245,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueInt64()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = UnixTimestampType{}
+	_ attr.Type                    = UnixTimestampType{}
+	_ basetypes.NumberTypable      = UnixTimestampType{}
+	_ xattr.TypeWithValidate       = UnixTimestampType{}
+)
+
+// UnixTimestampType implements the attr.Type interface for usage in schema
+// definitions and data models. Unlike the other types, values are Terraform
+// numbers rather than strings. Values are whole numbers of units since the
+// Unix epoch, such as 1136214245 seconds.
+//
+// The zero value uses seconds. Since the unit changes the meaning of values,
+// types with differing units are not considered equal.
+type UnixTimestampType struct {
+	// Unit is the unit of numbers since the Unix epoch. Defaults to
+	// UnixTimestampUnitSeconds.
+	Unit UnixTimestampUnit
+}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t UnixTimestampType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is UnixTimestampType with the same
+// unit.
+func (t UnixTimestampType) Equal(o attr.Type) bool {
+	other, ok := o.(UnixTimestampType)
+
+	if !ok {
+		return false
+	}
+
+	return t.Unit == other.Unit
+}
+
+// NullValue returns a null UnixTimestamp in the unit of the type. Use this
+// instead of UnixTimestampNull for units other than seconds, such as for
+// collection elements, since the value type includes the unit.
+func (t UnixTimestampType) NullValue() UnixTimestamp {
+	return UnixTimestamp{
+		null: true,
+		unit: t.Unit,
+	}
+}
+
+// String returns a human readable string of the type.
+func (t UnixTimestampType) String() string {
+	return "timetypes.UnixTimestampType[" + t.Unit.String() + "]"
+}
+
+// TerraformType always returns tftypes.Number.
+func (t UnixTimestampType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.Number
+}
+
+// UnknownValue returns an unknown UnixTimestamp in the unit of the type. Use
+// this instead of UnixTimestampUnknown for units other than seconds, such as
+// for collection elements, since the value type includes the unit.
+func (t UnixTimestampType) UnknownValue() UnixTimestamp {
+	return UnixTimestamp{
+		unknown: true,
+		unit:    t.Unit,
+	}
+}
+
+// Validate ensures the value is always a whole number of units between the
+// years 0000 and 9999, or which fits in an int64 for nanoseconds.
+func (t UnixTimestampType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	number := new(big.Float)
+
+	err := terraformValue.As(&number)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid Unix Timestamp Terraform Value",
+				"An unexpected error occurred while attempting to read a Unix timestamp number from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := unixTimestampNumber(number, t.Unit, schemaPath)
+
+	return diags
+}
+
+// ValueFromInt64 returns a known UnixTimestamp with the given number of units
+// since the Unix epoch. An error diagnostic is returned if the number is
+// outside the years 0000 to 9999.
+func (t UnixTimestampType) ValueFromInt64(value int64) (UnixTimestamp, diag.Diagnostics) {
+	number, err := t.Unit.fromInt64(value)
+
+	if err != nil {
+		return t.UnknownValue(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Unix Timestamp Int64 Value",
+				"An unexpected error occurred while converting an int64 to a Unix timestamp. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	return UnixTimestamp{
+		unit:  t.Unit,
+		value: number,
+	}, nil
+}
+
+// ValueFromNumber converts the types.Number into a value.
+func (t UnixTimestampType) ValueFromNumber(_ context.Context, in basetypes.NumberValue) (basetypes.NumberValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return t.NullValue(), nil
+	}
+
+	if in.IsUnknown() {
+		return t.UnknownValue(), nil
+	}
+
+	return unixTimestampNumber(in.ValueBigFloat(), t.Unit, path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t UnixTimestampType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return t.NullValue(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return t.UnknownValue(), nil
+	}
+
+	number := new(big.Float)
+
+	err := terraformValue.As(&number)
+
+	if err != nil {
+		return t.UnknownValue(), err
+	}
+
+	value, err := t.Unit.fromBigFloat(number)
+
+	if err != nil {
+		return t.UnknownValue(), err
+	}
+
+	return UnixTimestamp{
+		unit:  t.Unit,
+		value: value,
+	}, nil
+}
+
+// ValueFromTime returns a known UnixTimestamp with the given time in the
+// unit, truncating any smaller units. An error diagnostic is returned if the
+// time is outside the years 0000 to 9999 or does not fit in an int64 of the
+// unit, such as times after the year 2262 in nanoseconds.
+func (t UnixTimestampType) ValueFromTime(value time.Time) (UnixTimestamp, diag.Diagnostics) {
+	number, err := t.Unit.fromTime(value)
+
+	if err != nil {
+		return t.UnknownValue(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Unix Timestamp Time Value",
+				"An unexpected error occurred while converting a time to a Unix timestamp. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	return UnixTimestamp{
+		unit:  t.Unit,
+		value: number,
+	}, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t UnixTimestampType) ValueType(_ context.Context) attr.Value {
+	return UnixTimestamp{
+		unit: t.Unit,
+	}
+}
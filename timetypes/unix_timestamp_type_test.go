@@ -0,0 +1,716 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnixTimestampTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.UnixTimestampType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.UnixTimestampType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.UnixTimestampType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.UnixTimestampType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.UnixTimestampType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.UnixTimestampType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.UnixTimestampType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.UnixTimestampType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.UnixTimestampType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeListElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ timetypes.UnixTimestampType
+	}{
+		"milliseconds": {
+			typ: timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+		},
+		"seconds": {
+			typ: timetypes.UnixTimestampType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			known := testValueFrom(t, testCase.typ.ValueFromInt64, 1136214245)
+
+			got, diags := basetypes.NewListValue(
+				testCase.typ,
+				[]attr.Value{
+					testCase.typ.NullValue(),
+					testCase.typ.UnknownValue(),
+					known,
+				},
+			)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got.ElementType(context.Background()), testCase.typ); diff != "" {
+				t.Errorf("unexpected element type difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.UnixTimestampType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.UnixTimestampType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.UnixTimestampType": {
+			typ:      timetypes.UnixTimestampType{},
+			other:    timetypes.UnixTimestampType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.UnixTimestampType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.UnixTimestampType{},
+			other:    types.StringType,
+			expected: false,
+		},
+		"timetypes.UnixTimestampType-different-unit": {
+			typ:      timetypes.UnixTimestampType{},
+			other:    timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			expected: false,
+		},
+		"timetypes.UnixTimestampType-same-unit": {
+			typ:      timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			other:    timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeNullValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ timetypes.UnixTimestampType
+	}{
+		"milliseconds": {
+			typ: timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+		},
+		"seconds": {
+			typ: timetypes.UnixTimestampType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.NullValue()
+
+			if !got.IsNull() {
+				t.Errorf("expected null value, got: %s", got)
+			}
+
+			if diff := cmp.Diff(got.Type(context.Background()), testCase.typ); diff != "" {
+				t.Errorf("unexpected type difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.UnixTimestampType
+		expected string
+	}{
+		"default": {
+			typ:      timetypes.UnixTimestampType{},
+			expected: "timetypes.UnixTimestampType[seconds]",
+		},
+		"milliseconds": {
+			typ:      timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			expected: "timetypes.UnixTimestampType[milliseconds]",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.UnixTimestampType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.UnixTimestampType{},
+			expected: tftypes.Number,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeUnknownValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ timetypes.UnixTimestampType
+	}{
+		"milliseconds": {
+			typ: timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+		},
+		"seconds": {
+			typ: timetypes.UnixTimestampType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.UnknownValue()
+
+			if !got.IsUnknown() {
+				t.Errorf("expected unknown value, got: %s", got)
+			}
+
+			if diff := cmp.Diff(got.Type(context.Background()), testCase.typ); diff != "" {
+				t.Errorf("unexpected type difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.UnixTimestampType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-number": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Terraform Value",
+					"An unexpected error occurred while attempting to read a Unix timestamp number from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+				),
+			},
+		},
+		"number-null": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"number-unknown": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"number-value-invalid-fractional": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1136214245.5)),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Number Value",
+					"An unexpected error occurred while converting a number value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Error: number 1136214245.5 is not a whole number of seconds",
+				),
+			},
+		},
+		"number-value-invalid-year-after-9999": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1e18)),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Number Value",
+					"An unexpected error occurred while converting a number value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Error: number 1000000000000000000 is outside the range of -62167219200 to 253402300799 seconds",
+				),
+			},
+		},
+		"number-value-invalid-overflow": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, new(big.Float).SetUint64(math.MaxUint64)),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Number Value",
+					"An unexpected error occurred while converting a number value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Error: number 18446744073709551615 is outside the range of -62167219200 to 253402300799 seconds",
+				),
+			},
+		},
+		"number-value-valid": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1136214245)),
+			schemaPath:     path.Root("test"),
+		},
+		"number-value-valid-negative": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(-1136214245)),
+			schemaPath:     path.Root("test"),
+		},
+		"number-value-valid-zero": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(0)),
+			schemaPath:     path.Root("test"),
+		},
+		"number-value-invalid-milliseconds-fractional": {
+			typ:            timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1136214245000.5)),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Number Value",
+					"An unexpected error occurred while converting a number value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp is a whole number of milliseconds since 1970-01-01T00:00:00Z, such as 1136214245000.\n\n"+
+						"Error: number 1136214245000.5 is not a whole number of milliseconds",
+				),
+			},
+		},
+		"number-value-invalid-nanoseconds-overflow": {
+			typ:            timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds},
+			terraformValue: tftypes.NewValue(tftypes.Number, new(big.Float).SetUint64(math.MaxUint64)),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Unix Timestamp Number Value",
+					"An unexpected error occurred while converting a number value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp is a whole number of nanoseconds since 1970-01-01T00:00:00Z, such as 1136214245000000000.\n\n"+
+						"Error: number 18446744073709551615 is outside the range of -9223372036854775808 to 9223372036854775807 nanoseconds",
+				),
+			},
+		},
+		"number-value-valid-microseconds": {
+			typ:            timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMicroseconds},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1136214245000000)),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeValueFromNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.UnixTimestampType
+		numberValue   basetypes.NumberValue
+		expected      basetypes.NumberValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.UnixTimestampType{},
+			numberValue: types.NumberNull(),
+			expected:    timetypes.UnixTimestampNull(),
+		},
+		"unknown": {
+			typ:         timetypes.UnixTimestampType{},
+			numberValue: types.NumberUnknown(),
+			expected:    timetypes.UnixTimestampUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.UnixTimestampType{},
+			numberValue: types.NumberValue(big.NewFloat(1.5)),
+			expected:    timetypes.UnixTimestampUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Unix Timestamp Number Value",
+					"An unexpected error occurred while converting a number value that was expected to be a Unix timestamp. "+
+						"A Unix timestamp is a whole number of seconds since 1970-01-01T00:00:00Z, such as 1136214245.\n\n"+
+						"Error: number 1.5 is not a whole number of seconds",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.UnixTimestampType{},
+			numberValue: types.NumberValue(big.NewFloat(1136214245)),
+			expected:    testValueFrom(t, timetypes.UnixTimestampInt64, 1136214245),
+		},
+		"value-valid-milliseconds": {
+			typ:         timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			numberValue: types.NumberValue(big.NewFloat(1136214245123)),
+			expected:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}.ValueFromInt64, 1136214245123),
+		},
+		"value-valid-milliseconds-not-seconds": {
+			typ:         timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			numberValue: types.NumberValue(big.NewFloat(1136214245)),
+			expected:    testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}.ValueFromInt64, 1136214245),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromNumber(context.Background(), testCase.numberValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.UnixTimestampType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-number": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "1136214245"),
+			expected:       timetypes.UnixTimestampUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.String into *big.Float, expected *big.Float"),
+		},
+		"number-null": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, nil),
+			expected:       timetypes.UnixTimestampNull(),
+		},
+		"number-unknown": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expected:       timetypes.UnixTimestampUnknown(),
+		},
+		"number-value-invalid": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			expected:       timetypes.UnixTimestampUnknown(),
+			expectedError:  fmt.Errorf("number 1.5 is not a whole number of seconds"),
+		},
+		"number-value-invalid-year-after-9999": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1e18)),
+			expected:       timetypes.UnixTimestampUnknown(),
+			expectedError:  fmt.Errorf("number 1000000000000000000 is outside the range of -62167219200 to 253402300799 seconds"),
+		},
+		"number-value-valid": {
+			typ:            timetypes.UnixTimestampType{},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1136214245)),
+			expected:       testValueFrom(t, timetypes.UnixTimestampTime, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+		},
+		"number-value-valid-nanoseconds": {
+			typ:            timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds},
+			terraformValue: tftypes.NewValue(tftypes.Number, big.NewFloat(1136214245000000000)),
+			expected:       testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds}.ValueFromInt64, 1136214245000000000),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeValueFromTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.UnixTimestampType
+		value         time.Time
+		expected      int64
+		expectedDiags diag.Diagnostics
+	}{
+		"microseconds": {
+			typ:      timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMicroseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: 1136214245123456,
+		},
+		"milliseconds": {
+			typ:      timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: 1136214245123,
+		},
+		"milliseconds-negative": {
+			typ:      timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			value:    time.Date(1969, 12, 31, 23, 59, 59, 999999999, time.UTC),
+			expected: -1,
+		},
+		"nanoseconds": {
+			typ:      timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.UTC),
+			expected: 1136214245123456789,
+		},
+		"nanoseconds-overflow": {
+			typ:   timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds},
+			value: time.Date(2263, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Time Value",
+					"An unexpected error occurred while converting a time to a Unix timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: time 2263-01-01T00:00:00Z is outside the range of -9223372036854775808 to 9223372036854775807 nanoseconds",
+				),
+			},
+		},
+		"nanoseconds-underflow": {
+			typ:   timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitNanoseconds},
+			value: time.Date(1677, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Time Value",
+					"An unexpected error occurred while converting a time to a Unix timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: time 1677-01-01T00:00:00Z is outside the range of -9223372036854775808 to 9223372036854775807 nanoseconds",
+				),
+			},
+		},
+		"seconds": {
+			typ:      timetypes.UnixTimestampType{},
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 123456789, time.FixedZone("", -7*60*60)),
+			expected: 1136239445,
+		},		"seconds-year-after-9999": {
+			typ:   timetypes.UnixTimestampType{},
+			value: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Unix Timestamp Time Value",
+					"An unexpected error occurred while converting a time to a Unix timestamp. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: time 10000-01-01T00:00:00Z is outside the range of -62167219200 to 253402300799 seconds",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromTime(testCase.value)
+
+			if diff := cmp.Diff(got.ValueInt64(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Type(context.Background()), testCase.typ); diff != "" {
+				t.Errorf("unexpected type difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUnixTimestampTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.UnixTimestampType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.UnixTimestampType{},
+			expected: timetypes.UnixTimestamp{},
+		},
+		"milliseconds": {
+			typ:      timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds},
+			expected: testValueFrom(t, timetypes.UnixTimestampType{Unit: timetypes.UnixTimestampUnitMilliseconds}.ValueFromInt64, 0),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package timetypes
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// UnixTimestampUnit is the unit of UnixTimestampType numbers since the Unix
+// epoch. Numbers are limited to times between the years 0000 and 9999, which
+// RFC 3339 can represent, or the range of an int64 for nanoseconds.
+type UnixTimestampUnit int
+
+const (
+	// UnixTimestampUnitSeconds is whole seconds, such as 1136214245. This is
+	// the default.
+	UnixTimestampUnitSeconds UnixTimestampUnit = iota
+
+	// UnixTimestampUnitMilliseconds is whole milliseconds, such as
+	// 1136214245000. This is commonly used by JavaScript.
+	UnixTimestampUnitMilliseconds
+
+	// UnixTimestampUnitMicroseconds is whole microseconds, such as
+	// 1136214245000000.
+	UnixTimestampUnitMicroseconds
+
+	// UnixTimestampUnitNanoseconds is whole nanoseconds, such as
+	// 1136214245000000000. This is commonly used by logging systems and
+	// can only represent times between the years 1677 and 2262.
+	UnixTimestampUnitNanoseconds
+)
+
+const (
+	// unixTimestampMinSeconds is 0000-01-01T00:00:00Z in seconds since the
+	// Unix epoch.
+	unixTimestampMinSeconds int64 = -62167219200
+
+	// unixTimestampMaxSeconds is 9999-12-31T23:59:59Z in seconds since the
+	// Unix epoch.
+	unixTimestampMaxSeconds int64 = 253402300799
+)
+
+// String returns a human readable string of the unit, such as seconds.
+func (u UnixTimestampUnit) String() string {
+	switch u {
+	case UnixTimestampUnitMilliseconds:
+		return "milliseconds"
+	case UnixTimestampUnitMicroseconds:
+		return "microseconds"
+	case UnixTimestampUnitNanoseconds:
+		return "nanoseconds"
+	default:
+		return "seconds"
+	}
+}
+
+// example returns 2006-01-02T15:04:05Z in the unit, for diagnostics.
+func (u UnixTimestampUnit) example() string {
+	return strconv.FormatInt(1136214245*u.perSecond(), 10)
+}
+
+// fromBigFloat returns the number as an int64 or an error if it is not a
+// whole number or does not fit in an int64.
+func (u UnixTimestampUnit) fromBigFloat(f *big.Float) (int64, error) {
+	if f == nil {
+		return 0, errors.New("number is missing")
+	}
+
+	if !f.IsInt() {
+		return 0, errors.New("number " + f.Text('f', -1) + " is not a whole number of " + u.String())
+	}
+
+	value, accuracy := f.Int64()
+
+	if accuracy != big.Exact || value < u.minValue() || value > u.maxValue() {
+		return 0, errors.New("number " + f.Text('f', -1) + " is outside the range of " + u.describeRange())
+	}
+
+	return value, nil
+}
+
+// fromInt64 returns the number or an error if it is outside the range of the
+// unit.
+func (u UnixTimestampUnit) fromInt64(value int64) (int64, error) {
+	if value < u.minValue() || value > u.maxValue() {
+		return 0, errors.New("number " + strconv.FormatInt(value, 10) + " is outside the range of " + u.describeRange())
+	}
+
+	return value, nil
+}
+
+// fromTime returns the time in the unit, truncating any smaller units, or an
+// error if it is outside the range of the unit.
+func (u UnixTimestampUnit) fromTime(t time.Time) (int64, error) {
+	value := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(u.perSecond()))
+	value.Add(value, big.NewInt(int64(t.Nanosecond())/(int64(time.Second)/u.perSecond())))
+
+	if !value.IsInt64() || value.Int64() < u.minValue() || value.Int64() > u.maxValue() {
+		return 0, errors.New("time " + t.Format(time.RFC3339Nano) + " is outside the range of " + u.describeRange())
+	}
+
+	return value.Int64(), nil
+}
+
+// describeRange returns the minimum and maximum numbers of the unit, such as
+// "-62167219200 to 253402300799 seconds", for errors.
+func (u UnixTimestampUnit) describeRange() string {
+	return strconv.FormatInt(u.minValue(), 10) + " to " + strconv.FormatInt(u.maxValue(), 10) + " " + u.String()
+}
+
+// maxValue returns 9999-12-31T23:59:59.999999999Z in the unit, truncating any
+// smaller units, or the maximum int64 for nanoseconds, which cannot represent
+// the year 9999.
+func (u UnixTimestampUnit) maxValue() int64 {
+	if u == UnixTimestampUnitNanoseconds {
+		return math.MaxInt64
+	}
+
+	return (unixTimestampMaxSeconds+1)*u.perSecond() - 1
+}
+
+// minValue returns 0000-01-01T00:00:00Z in the unit, or the minimum int64 for
+// nanoseconds, which cannot represent the year 0000.
+func (u UnixTimestampUnit) minValue() int64 {
+	if u == UnixTimestampUnitNanoseconds {
+		return math.MinInt64
+	}
+
+	return unixTimestampMinSeconds * u.perSecond()
+}
+
+// parse parses a string of an optional minus sign followed by decimal digits
+// as a number in the range of the unit. Any returned error is a *ParseError.
+func (u UnixTimestampUnit) parse(s string) (int64, error) {
+	offset := 0
+
+	if offset < len(s) && s[offset] == '-' {
+		offset++
+	}
+
+	if offset == len(s) {
+		return 0, u.parseError(s, offset, "one or more digits")
+	}
+
+	for ; offset < len(s); offset++ {
+		if !isDigit(s[offset]) {
+			if offset == 0 {
+				return 0, u.parseError(s, offset, `"-" or digit`)
+			}
+
+			return 0, u.parseError(s, offset, "digit or end of string")
+		}
+	}
+
+	value, err := strconv.ParseInt(s, 10, 64)
+
+	if err != nil || value < u.minValue() || value > u.maxValue() {
+		return 0, u.parseError(s, 0, strconv.FormatInt(u.minValue(), 10)+" to "+strconv.FormatInt(u.maxValue(), 10))
+	}
+
+	return value, nil
+}
+
+// parseError returns a *ParseError at the offset of the string.
+func (u UnixTimestampUnit) parseError(s string, offset int, expected string) error {
+	return &ParseError{
+		Format:    "Unix timestamp",
+		Input:     s,
+		Offset:    offset,
+		Component: u.String(),
+		Expected:  expected,
+	}
+}
+
+// perSecond returns the number of units in a second.
+func (u UnixTimestampUnit) perSecond() int64 {
+	switch u {
+	case UnixTimestampUnitMilliseconds:
+		return int64(time.Second / time.Millisecond)
+	case UnixTimestampUnitMicroseconds:
+		return int64(time.Second / time.Microsecond)
+	case UnixTimestampUnitNanoseconds:
+		return int64(time.Second)
+	default:
+		return 1
+	}
+}
+
+// toRFC3339 returns the number in the unit as an RFC3339 in UTC.
+func (u UnixTimestampUnit) toRFC3339(value int64) (RFC3339, diag.Diagnostics) {
+	return RFC3339Time(u.toTime(value))
+}
+
+// toTime returns the time.Time in UTC of the number in the unit.
+func (u UnixTimestampUnit) toTime(value int64) time.Time {
+	switch u {
+	case UnixTimestampUnitMilliseconds:
+		return time.UnixMilli(value).UTC()
+	case UnixTimestampUnitMicroseconds:
+		return time.UnixMicro(value).UTC()
+	case UnixTimestampUnitNanoseconds:
+		return time.Unix(0, value).UTC()
+	default:
+		return time.Unix(value, 0).UTC()
+	}
+}
@@ -0,0 +1,185 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value                                 = UTCOffset{}
+	_ basetypes.StringValuable                   = UTCOffset{}
+	_ basetypes.StringValuableWithSemanticEquals = UTCOffset{}
+)
+
+// UTCOffsetNull returns a null UTCOffset.
+func UTCOffsetNull() UTCOffset {
+	return UTCOffset{
+		stringValue: stringValue{
+			null: true,
+		},
+	}
+}
+
+// UTCOffsetString returns a known UTCOffset or any errors while attempting
+// to parse the string as RFC 3339 time-offset format.
+func UTCOffsetString(s string, schemaPath path.Path) (UTCOffset, diag.Diagnostics) {
+	offset, err := parseTimeOffset(s)
+
+	if err != nil {
+		return UTCOffset{
+				stringValue: stringValue{
+					unknown: true,
+				},
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid UTC Offset String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 time-offset format. "+
+						"The RFC 3339 time-offset string format is Z or +HH:MM or -HH:MM, such as +05:30 or -08:00, "+
+						"with hours 00 through 23.\n\n"+
+						parseErrorDetail(err),
+				),
+			}
+	}
+
+	return UTCOffset{
+		stringValue: stringValue{
+			valueString: s,
+		},
+		value: offset,
+	}, nil
+}
+
+// UTCOffsetTime returns a known UTCOffset with the offset of the given time
+// in its location. The string representation is Z for UTC, otherwise +HH:MM
+// or -HH:MM. An error diagnostic is returned if the offset is not a whole
+// number of minutes, such as in historical local mean time, or has hours
+// outside 00 to 23.
+func UTCOffsetTime(t time.Time) (UTCOffset, diag.Diagnostics) {
+	_, offset := t.Zone()
+
+	if offset%60 != 0 || offset <= -24*60*60 || offset >= 24*60*60 {
+		return UTCOffsetUnknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"UTC Offset Conversion Error",
+				"An unexpected error occurred while converting a time to a UTC offset. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Time "+t.Format(time.RFC3339Nano)+" has the offset "+(time.Duration(offset)*time.Second).String()+", "+
+					"which RFC 3339 time-offset cannot represent. Offsets must be whole minutes with hours 00 through 23.",
+			),
+		}
+	}
+
+	return UTCOffset{
+		stringValue: stringValue{
+			valueString: t.Format("Z07:00"),
+		},
+		value: offset,
+	}, nil
+}
+
+// UTCOffsetUnknown returns an unknown UTCOffset.
+func UTCOffsetUnknown() UTCOffset {
+	return UTCOffset{
+		stringValue: stringValue{
+			unknown: true,
+		},
+	}
+}
+
+// UTCOffset implements the attr.Value interface for usage in logic. It
+// represents an offset from UTC without any date or time zone rules, such as
+// +05:30.
+type UTCOffset struct {
+	stringValue
+
+	// value is the offset in seconds east of UTC.
+	value int
+}
+
+// Duration returns the offset of a UTCOffset as a time.Duration, which is
+// negative for offsets west of UTC, such as -8h for -08:00.
+func (v UTCOffset) Duration() time.Duration {
+	return time.Duration(v.value) * time.Second
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a UTCOffset type
+//   - Has the same null, unknown, and string representation data
+//
+// Use StringSemanticEquals to compare the represented offsets instead.
+func (v UTCOffset) Equal(o attr.Value) bool {
+	otherValue, ok := o.(UTCOffset)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// IsUnknownLocalOffset returns true if the UTCOffset is -00:00, which
+// RFC 3339 section 4.3 defines as a time in UTC where the offset to the
+// local time is unknown.
+func (v UTCOffset) IsUnknownLocalOffset() bool {
+	return v.valueString == "-00:00"
+}
+
+// Location returns a *time.Location with the fixed offset of a UTCOffset,
+// such as for combining with Date.TimeIn. The time.UTC location is returned
+// for the Z, +00:00, and -00:00 offsets. A nil *time.Location is returned
+// for a null or unknown UTCOffset.
+func (v UTCOffset) Location() *time.Location {
+	if v.null || v.unknown {
+		return nil
+	}
+
+	if v.value == 0 {
+		return time.UTC
+	}
+
+	return time.FixedZone(v.valueString, v.value)
+}
+
+// StringSemanticEquals returns true if the given UTCOffset represents the
+// same offset, regardless of the string representation. The Z, +00:00, and
+// -00:00 offsets are all considered equal, since each refers to times in
+// UTC. The framework calls this method to keep the prior value and prevent
+// unexpected differences.
+func (v UTCOffset) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(UTCOffset)
+
+	if !ok {
+		diags.Append(semanticEqualityCheckError(v, newValuable))
+
+		return false, diags
+	}
+
+	return v.value == newValue.value, diags
+}
+
+// Time returns the instant in time of the given TimeOfDay on the given Date
+// at the UTCOffset, such as 2006-01-02T15:04:05+05:30 for the 2006-01-02 Date,
+// 15:04:05 TimeOfDay, and +05:30 UTCOffset. The -00:00 offset results in the
+// time in UTC, following RFC 3339 section 4.3. A zero time.Time is returned
+// if any value is null or unknown.
+func (v UTCOffset) Time(date Date, timeOfDay TimeOfDay) time.Time {
+	if v.null || v.unknown || date.null || date.unknown || timeOfDay.null || timeOfDay.unknown {
+		return time.Time{}
+	}
+
+	return time.Date(date.year, date.month, date.day, timeOfDay.hour, timeOfDay.minute, timeOfDay.second, timeOfDay.nanosecond, v.Location())
+}
+
+// Type returns the attr.Type of UTCOffset.
+func (v UTCOffset) Type(_ context.Context) attr.Type {
+	return UTCOffsetType{}
+}
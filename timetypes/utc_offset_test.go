@@ -0,0 +1,766 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUTCOffsetDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		expected time.Duration
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: 0,
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: 0,
+		},
+		"value-negative": {
+			value:    testValue(t, timetypes.UTCOffsetString, "-08:00"),
+			expected: -8 * time.Hour,
+		},
+		"value-positive": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:45"),
+			expected: 5*time.Hour + 45*time.Minute,
+		},
+		"value-z": {
+			value:    testValue(t, timetypes.UTCOffsetString, "Z"),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Duration()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.UTCOffsetNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.UTCOffset": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			other:    types.StringValue("+05:30"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.UTCOffsetNull(),
+			other:    timetypes.UTCOffsetNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.UTCOffsetNull(),
+			other:    timetypes.UTCOffsetUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.UTCOffsetNull(),
+			other:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.UTCOffsetUnknown(),
+			other:    timetypes.UTCOffsetNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			other:    timetypes.UTCOffsetUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.UTCOffsetUnknown(),
+			other:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			other:    timetypes.UTCOffsetNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			other:    timetypes.UTCOffsetUnknown(),
+			expected: false,
+		},
+		"value-value-different-string-same-offset": {
+			value:    testValue(t, timetypes.UTCOffsetString, "Z"),
+			other:    testValue(t, timetypes.UTCOffsetString, "+00:00"),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			other:    testValue(t, timetypes.UTCOffsetString, "-08:00"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			other:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetIsUnknownLocalOffset(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: false,
+		},
+		"value-negative-zero": {
+			value:    testValue(t, timetypes.UTCOffsetString, "-00:00"),
+			expected: true,
+		},
+		"value-positive-zero": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+00:00"),
+			expected: false,
+		},
+		"value-z": {
+			value:    testValue(t, timetypes.UTCOffsetString, "Z"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknownLocalOffset()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		expected *time.Location
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: nil,
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: nil,
+		},
+		"value-negative": {
+			value:    testValue(t, timetypes.UTCOffsetString, "-08:00"),
+			expected: time.FixedZone("-08:00", -8*60*60),
+		},
+		"value-negative-zero": {
+			value:    testValue(t, timetypes.UTCOffsetString, "-00:00"),
+			expected: time.UTC,
+		},
+		"value-positive": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: time.FixedZone("+05:30", 5*60*60+30*60),
+		},
+		"value-z": {
+			value:    testValue(t, timetypes.UTCOffsetString, "Z"),
+			expected: time.UTC,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Location()
+
+			if testCase.expected == nil {
+				if got != nil {
+					t.Fatalf("expected no location, got: %s", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected location %s, got none", testCase.expected)
+			}
+
+			// Compare the wall clock time of the same instant, which also
+			// verifies the location can be combined with Date.TimeIn.
+			instant := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+			if diff := cmp.Diff(instant.In(got).Format(time.RFC3339), instant.In(testCase.expected).Format(time.RFC3339)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.String(), testCase.expected.String()); diff != "" {
+				t.Errorf("unexpected name difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.UTCOffset
+		newValue      basetypes.StringValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not-timetypes.UTCOffset": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			newValue: types.StringValue("+05:30"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.UTCOffset\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			newValue: testValue(t, timetypes.UTCOffsetString, "-05:30"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			newValue: testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: true,
+		},
+		"value-value-lowercase-z": {
+			value:    testValue(t, timetypes.UTCOffsetString, "z"),
+			newValue: testValue(t, timetypes.UTCOffsetString, "Z"),
+			expected: true,
+		},
+		"value-value-negative-zero-positive-zero": {
+			value:    testValue(t, timetypes.UTCOffsetString, "-00:00"),
+			newValue: testValue(t, timetypes.UTCOffsetString, "+00:00"),
+			expected: true,
+		},
+		"value-value-negative-zero-z": {
+			value:    testValue(t, timetypes.UTCOffsetString, "-00:00"),
+			newValue: testValue(t, timetypes.UTCOffsetString, "Z"),
+			expected: true,
+		},
+		"value-value-positive-zero-z": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+00:00"),
+			newValue: testValue(t, timetypes.UTCOffsetString, "Z"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.StringSemanticEquals(context.Background(), testCase.newValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		expected string
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: "\"+05:30\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		time          time.Time
+		expected      timetypes.UTCOffset
+		expectedDiags diag.Diagnostics
+	}{
+		"hours-24": {
+			time: time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("", 24*60*60)),
+			expected: timetypes.UTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UTC Offset Conversion Error",
+					"An unexpected error occurred while converting a time to a UTC offset. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 2006-01-02T15:04:05+24:00 has the offset 24h0m0s, "+
+						"which RFC 3339 time-offset cannot represent. Offsets must be whole minutes with hours 00 through 23.",
+				),
+			},
+		},
+		"local-mean-time": {
+			time: time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("LMT", 19*60+32)),
+			expected: timetypes.UTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UTC Offset Conversion Error",
+					"An unexpected error occurred while converting a time to a UTC offset. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 2006-01-02T15:04:05+00:19 has the offset 19m32s, "+
+						"which RFC 3339 time-offset cannot represent. Offsets must be whole minutes with hours 00 through 23.",
+				),
+			},
+		},
+		"local-mean-time-negative": {
+			time: time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("LMT", -(4*60*60+56*60+2))),
+			expected: timetypes.UTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"UTC Offset Conversion Error",
+					"An unexpected error occurred while converting a time to a UTC offset. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time 2006-01-02T15:04:05-04:56 has the offset -4h56m2s, "+
+						"which RFC 3339 time-offset cannot represent. Offsets must be whole minutes with hours 00 through 23.",
+				),
+			},
+		},
+		"negative": {
+			time:     time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("", -8*60*60)),
+			expected: testValue(t, timetypes.UTCOffsetString, "-08:00"),
+		},
+		"positive": {
+			time:     time.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("", 5*60*60+30*60)),
+			expected: testValue(t, timetypes.UTCOffsetString, "+05:30"),
+		},
+		"utc": {
+			time:     time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC),
+			expected: testValue(t, timetypes.UTCOffsetString, "Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.UTCOffsetTime(testCase.time)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Duration(), testCase.expected.Duration()); diff != "" {
+				t.Errorf("unexpected duration difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTimeDateTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value     timetypes.UTCOffset
+		date      timetypes.Date
+		timeOfDay timetypes.TimeOfDay
+		expected  string
+	}{
+		"negative": {
+			value:     testValue(t, timetypes.UTCOffsetString, "-08:00"),
+			date:      testValue(t, timetypes.DateString, "2006-01-02"),
+			timeOfDay: testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected:  "2006-01-02T15:04:05-08:00",
+		},
+		"null": {
+			value:     timetypes.UTCOffsetNull(),
+			date:      testValue(t, timetypes.DateString, "2006-01-02"),
+			timeOfDay: testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected:  "0001-01-01T00:00:00Z",
+		},
+		"null-date": {
+			value:     testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			date:      timetypes.DateNull(),
+			timeOfDay: testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected:  "0001-01-01T00:00:00Z",
+		},
+		"positive": {
+			value:     testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			date:      testValue(t, timetypes.DateString, "2006-01-02"),
+			timeOfDay: testValue(t, timetypes.TimeOfDayString, "15:04:05.999"),
+			expected:  "2006-01-02T15:04:05.999+05:30",
+		},
+		"unknown": {
+			value:     timetypes.UTCOffsetUnknown(),
+			date:      testValue(t, timetypes.DateString, "2006-01-02"),
+			timeOfDay: testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected:  "0001-01-01T00:00:00Z",
+		},
+		"unknown-local-offset": {
+			value:     testValue(t, timetypes.UTCOffsetString, "-00:00"),
+			date:      testValue(t, timetypes.DateString, "2006-01-02"),
+			timeOfDay: testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected:  "2006-01-02T15:04:05Z",
+		},
+		"unknown-time-of-day": {
+			value:     testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			date:      testValue(t, timetypes.DateString, "2006-01-02"),
+			timeOfDay: timetypes.TimeOfDayUnknown(),
+			expected:  "0001-01-01T00:00:00Z",
+		},
+		"z": {
+			value:     testValue(t, timetypes.UTCOffsetString, "Z"),
+			date:      testValue(t, timetypes.DateString, "2006-01-02"),
+			timeOfDay: testValue(t, timetypes.TimeOfDayString, "15:04:05"),
+			expected:  "2006-01-02T15:04:05Z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Time(testCase.date, testCase.timeOfDay)
+
+			if diff := cmp.Diff(got.Format(time.RFC3339Nano), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.UTCOffset
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: types.StringValue("+05:30"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.UTCOffset
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+			expected: tftypes.NewValue(tftypes.String, "+05:30"),
+		},
+		"value-zero": {
+			value:    testValue(t, timetypes.UTCOffsetString, "+00:00"),
+			expected: tftypes.NewValue(tftypes.String, "+00:00"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: timetypes.UTCOffsetType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.UTCOffset
+		expected string
+	}{
+		"null": {
+			value:    timetypes.UTCOffsetNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.UTCOffsetUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.UTCOffsetString, "-08:00"),
+			expected: "-08:00",
+		},
+		"value-lowercase-z": {
+			value:    testValue(t, timetypes.UTCOffsetString, "z"),
+			expected: "z",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
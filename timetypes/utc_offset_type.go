@@ -0,0 +1,126 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = UTCOffsetType{}
+	_ attr.Type                    = UTCOffsetType{}
+	_ basetypes.StringTypable      = UTCOffsetType{}
+	_ xattr.TypeWithValidate       = UTCOffsetType{}
+)
+
+// UTCOffsetType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are RFC 3339 time-offset strings, such
+// as Z, +05:30, or -08:00.
+type UTCOffsetType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t UTCOffsetType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is UTCOffsetType.
+func (t UTCOffsetType) Equal(o attr.Type) bool {
+	_, ok := o.(UTCOffsetType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t UTCOffsetType) String() string {
+	return "timetypes.UTCOffsetType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t UTCOffsetType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always RFC 3339 time-offset conformant.
+func (t UTCOffsetType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid UTC Offset Terraform Value",
+				"An unexpected error occurred while attempting to read a UTC offset string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := UTCOffsetString(str, schemaPath)
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t UTCOffsetType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return UTCOffsetNull(), nil
+	}
+
+	if in.IsUnknown() {
+		return UTCOffsetUnknown(), nil
+	}
+
+	return UTCOffsetString(in.ValueString(), path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t UTCOffsetType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return UTCOffsetNull(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return UTCOffsetUnknown(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return UTCOffsetUnknown(), err
+	}
+
+	offset, err := parseTimeOffset(str)
+
+	if err != nil {
+		return UTCOffsetUnknown(), err
+	}
+
+	return UTCOffset{
+		stringValue: stringValue{
+			valueString: str,
+		},
+		value: offset,
+	}, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t UTCOffsetType) ValueType(_ context.Context) attr.Value {
+	return UTCOffset{}
+}
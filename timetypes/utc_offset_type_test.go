@@ -0,0 +1,512 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUTCOffsetTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.UTCOffsetType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.UTCOffsetType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.UTCOffsetType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.UTCOffsetType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.UTCOffsetType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.UTCOffsetType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.UTCOffsetType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.UTCOffsetType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.UTCOffsetType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.UTCOffsetType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.UTCOffsetType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.UTCOffsetType": {
+			typ:      timetypes.UTCOffsetType{},
+			other:    timetypes.UTCOffsetType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.UTCOffsetType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.UTCOffsetType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.UTCOffsetType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.UTCOffsetType{},
+			expected: "timetypes.UTCOffsetType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.UTCOffsetType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.UTCOffsetType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.UTCOffsetType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset Terraform Value",
+					"An unexpected error occurred while attempting to read a UTC offset string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-invalid-empty": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, ""),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 time-offset format. "+
+						"The RFC 3339 time-offset string format is Z or +HH:MM or -HH:MM, such as +05:30 or -08:00, "+
+						"with hours 00 through 23.\n\n"+
+						"Invalid time-offset at character 1, expected \"Z\" or \"+\" or \"-\":\n\n"+
+						"    \n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-hour-range": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "+24:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 time-offset format. "+
+						"The RFC 3339 time-offset string format is Z or +HH:MM or -HH:MM, such as +05:30 or -08:00, "+
+						"with hours 00 through 23.\n\n"+
+						"Invalid time-numoffset at character 2, expected 00-23:\n\n"+
+						"    +24:00\n"+
+						"     ^",
+				),
+			},
+		},
+		"string-value-invalid-minute-range": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-08:60"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 time-offset format. "+
+						"The RFC 3339 time-offset string format is Z or +HH:MM or -HH:MM, such as +05:30 or -08:00, "+
+						"with hours 00 through 23.\n\n"+
+						"Invalid time-numoffset at character 5, expected 00-59:\n\n"+
+						"    -08:60\n"+
+						"        ^",
+				),
+			},
+		},
+		"string-value-invalid-missing-colon": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "+0530"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 time-offset format. "+
+						"The RFC 3339 time-offset string format is Z or +HH:MM or -HH:MM, such as +05:30 or -08:00, "+
+						"with hours 00 through 23.\n\n"+
+						"Invalid time-numoffset at character 4, expected \":\":\n\n"+
+						"    +0530\n"+
+						"       ^",
+				),
+			},
+		},
+		"string-value-invalid-missing-sign": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "05:30"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 time-offset format. "+
+						"The RFC 3339 time-offset string format is Z or +HH:MM or -HH:MM, such as +05:30 or -08:00, "+
+						"with hours 00 through 23.\n\n"+
+						"Invalid time-offset at character 1, expected \"Z\" or \"+\" or \"-\":\n\n"+
+						"    05:30\n"+
+						"    ^",
+				),
+			},
+		},
+		"string-value-invalid-seconds": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "+05:30:00"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid UTC Offset String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 time-offset format. "+
+						"The RFC 3339 time-offset string format is Z or +HH:MM or -HH:MM, such as +05:30 or -08:00, "+
+						"with hours 00 through 23.\n\n"+
+						"Invalid time-offset at character 7, expected end of string:\n\n"+
+						"    +05:30:00\n"+
+						"          ^",
+				),
+			},
+		},
+		"string-value-valid-negative": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-08:00"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-negative-zero": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-00:00"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-positive": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "+05:30"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-z": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Z"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.UTCOffsetType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.UTCOffsetType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.UTCOffsetNull(),
+		},
+		"unknown": {
+			typ:         timetypes.UTCOffsetType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.UTCOffsetUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.UTCOffsetType{},
+			stringValue: types.StringValue("+24:00"),
+			expected:    timetypes.UTCOffsetUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid UTC Offset String Value",
+					"An unexpected error occurred while converting a string value that was expected to be RFC 3339 time-offset format. "+
+						"The RFC 3339 time-offset string format is Z or +HH:MM or -HH:MM, such as +05:30 or -08:00, "+
+						"with hours 00 through 23.\n\n"+
+						"Invalid time-numoffset at character 2, expected 00-23:\n\n"+
+						"    +24:00\n"+
+						"     ^",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.UTCOffsetType{},
+			stringValue: types.StringValue("+05:30"),
+			expected:    testValue(t, timetypes.UTCOffsetString, "+05:30"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.UTCOffsetType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.UTCOffsetUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.UTCOffsetNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.UTCOffsetUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "+24:00"),
+			expected:       timetypes.UTCOffsetUnknown(),
+			expectedError:  fmt.Errorf("parsing \"+24:00\" as RFC 3339 time-offset: invalid time-numoffset at offset 1: expected 00-23"),
+		},
+		"string-value-valid": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "+05:30"),
+			expected:       testValue(t, timetypes.UTCOffsetString, "+05:30"),
+		},
+		"string-value-valid-negative-zero": {
+			typ:            timetypes.UTCOffsetType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "-00:00"),
+			expected:       testValue(t, timetypes.UTCOffsetString, "-00:00"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUTCOffsetTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.UTCOffsetType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.UTCOffsetType{},
+			expected: timetypes.UTCOffset{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ attr.Value               = WindowsTimeZone{}
+	_ basetypes.StringValuable = WindowsTimeZone{}
+)
+
+// WindowsTimeZoneNull returns a null WindowsTimeZone.
+func WindowsTimeZoneNull() WindowsTimeZone {
+	return WindowsTimeZone{
+		stringValue: stringValue{
+			null: true,
+		},
+	}
+}
+
+// WindowsTimeZoneString returns a known WindowsTimeZone or any errors while
+// attempting to load the string as a Windows time zone ID.
+func WindowsTimeZoneString(s string, schemaPath path.Path) (WindowsTimeZone, diag.Diagnostics) {
+	value, err := loadWindowsTimeZone(s)
+
+	if err != nil {
+		errDetail := "Error: " + err.Error()
+
+		if suggestion := windowsTimeZoneSuggestion(s); suggestion != "" {
+			errDetail += "\n\nDid you mean " + suggestion + "?"
+		}
+
+		return WindowsTimeZone{
+				stringValue: stringValue{
+					unknown: true,
+				},
+			}, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					schemaPath,
+					"Invalid Windows Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Windows time zone ID. "+
+						"The Windows time zone ID format is a time zone name from the Windows registry, such as Pacific Standard Time or W. Europe Standard Time.\n\n"+
+						errDetail,
+				),
+			}
+	}
+
+	return value, nil
+}
+
+// WindowsTimeZoneTimeZone returns a known WindowsTimeZone converted from the
+// given TimeZone or an error diagnostic if the CLDR windowsZones mapping has
+// no Windows time zone ID for it. Deprecated IANA time zone names are
+// converted using their preferred names. A null or unknown TimeZone returns
+// a null or unknown WindowsTimeZone.
+func WindowsTimeZoneTimeZone(value TimeZone) (WindowsTimeZone, diag.Diagnostics) {
+	if value.null {
+		return WindowsTimeZoneNull(), nil
+	}
+
+	if value.unknown {
+		return WindowsTimeZoneUnknown(), nil
+	}
+
+	id, ok := windowsZoneIDs[value.PreferredName()]
+
+	if !ok {
+		return WindowsTimeZoneUnknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Windows Time Zone Conversion Error",
+				"An unexpected error occurred while converting a time zone to a Windows time zone ID. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Time zone "+value.valueString+" has no Windows time zone ID in the CLDR windowsZones mapping.",
+			),
+		}
+	}
+
+	result, err := loadWindowsTimeZone(id)
+
+	if err != nil {
+		return WindowsTimeZoneUnknown(), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Windows Time Zone Conversion Error",
+				"An unexpected error occurred while converting a time zone to a Windows time zone ID. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	return result, nil
+}
+
+// WindowsTimeZoneUnknown returns an unknown WindowsTimeZone.
+func WindowsTimeZoneUnknown() WindowsTimeZone {
+	return WindowsTimeZone{
+		stringValue: stringValue{
+			unknown: true,
+		},
+	}
+}
+
+// WindowsTimeZone implements the attr.Value interface for usage in logic. It
+// represents a Windows time zone ID, such as Pacific Standard Time, which
+// is mapped to the preferred IANA time zone of the [CLDR windowsZones]
+// mapping, such as America/Los_Angeles.
+//
+// [CLDR windowsZones]: https://github.com/unicode-org/cldr/blob/main/common/supplemental/windowsZones.xml
+type WindowsTimeZone struct {
+	stringValue
+
+	value *time.Location
+
+	// timeZoneName is the preferred IANA time zone name of the Windows time
+	// zone ID.
+	timeZoneName string
+}
+
+// Equal returns true if the given attr.Value matches the following:
+//   - Is a WindowsTimeZone type
+//   - Has the same null, unknown, and string representation data
+func (v WindowsTimeZone) Equal(o attr.Value) bool {
+	otherValue, ok := o.(WindowsTimeZone)
+
+	if !ok {
+		return false
+	}
+
+	return v.stringValue.equal(otherValue.stringValue)
+}
+
+// Location returns the *time.Location of the preferred IANA time zone of a
+// WindowsTimeZone, such as America/Los_Angeles for Pacific Standard Time. A
+// nil *time.Location is returned for a null or unknown WindowsTimeZone.
+func (v WindowsTimeZone) Location() *time.Location {
+	return v.value
+}
+
+// ToTimeZone converts the WindowsTimeZone to a TimeZone with the preferred
+// IANA time zone name, such as America/Los_Angeles for Pacific Standard
+// Time. A null or unknown WindowsTimeZone returns a null or unknown
+// TimeZone.
+func (v WindowsTimeZone) ToTimeZone() TimeZone {
+	if v.null {
+		return TimeZoneNull()
+	}
+
+	if v.unknown {
+		return TimeZoneUnknown()
+	}
+
+	return TimeZone{
+		stringValue: stringValue{
+			valueString: v.timeZoneName,
+		},
+		value: v.value,
+	}
+}
+
+// Type returns the attr.Type of WindowsTimeZone.
+func (v WindowsTimeZone) Type(_ context.Context) attr.Type {
+	return WindowsTimeZoneType{}
+}
+
+// loadWindowsTimeZone returns a known WindowsTimeZone with the location of
+// the preferred IANA time zone of the Windows time zone ID.
+func loadWindowsTimeZone(id string) (WindowsTimeZone, error) {
+	name, ok := windowsZoneIANANames[id]
+
+	if !ok {
+		return WindowsTimeZone{stringValue: stringValue{unknown: true}}, fmt.Errorf("unknown Windows time zone ID %s", id)
+	}
+
+	loc, err := loadTimeZone(name)
+
+	if err != nil {
+		return WindowsTimeZone{stringValue: stringValue{unknown: true}}, fmt.Errorf("loading time zone %s of Windows time zone ID %s: %w", name, id, err)
+	}
+
+	return WindowsTimeZone{
+		stringValue: stringValue{
+			valueString: id,
+		},
+		value:        loc,
+		timeZoneName: name,
+	}, nil
+}
+
+// windowsTimeZoneSuggestion returns the Windows time zone ID for common
+// mistakes, such as differing letter case or an IANA time zone name. An
+// empty string is returned if there is no suggestion.
+func windowsTimeZoneSuggestion(s string) string {
+	if id, ok := windowsZoneIDs[timeZonePreferredName(s)]; ok {
+		return id
+	}
+
+	normalized := strings.Join(strings.Fields(s), " ")
+
+	for id := range windowsZoneIANANames {
+		if strings.EqualFold(id, normalized) {
+			return id
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,550 @@
+package timetypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWindowsTimeZoneEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.WindowsTimeZone
+		other    attr.Value
+		expected bool
+	}{
+		"nil": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			other:    nil,
+			expected: false,
+		},
+		"not-timetypes.WindowsTimeZone": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			other:    types.StringValue("Eastern Standard Time"),
+			expected: false,
+		},
+		"null-null": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			other:    timetypes.WindowsTimeZoneNull(),
+			expected: true,
+		},
+		"null-unknown": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			other:    timetypes.WindowsTimeZoneUnknown(),
+			expected: false,
+		},
+		"null-value": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			other:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expected: false,
+		},
+		"unknown-null": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			other:    timetypes.WindowsTimeZoneNull(),
+			expected: false,
+		},
+		"unknown-unknown": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			other:    timetypes.WindowsTimeZoneUnknown(),
+			expected: true,
+		},
+		"unknown-value": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			other:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expected: false,
+		},
+		"value-null": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			other:    timetypes.WindowsTimeZoneNull(),
+			expected: false,
+		},
+		"value-unknown": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			other:    timetypes.WindowsTimeZoneUnknown(),
+			expected: false,
+		},
+		"value-value-different": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			other:    testValue(t, timetypes.WindowsTimeZoneString, "Central Standard Time"),
+			expected: false,
+		},
+		"value-value-equal": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			other:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.WindowsTimeZone
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			expected: false,
+		},
+		"value": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.WindowsTimeZone
+		expected bool
+	}{
+		"null": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			expected: false,
+		},
+		"unknown": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			expected: true,
+		},
+		"value": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value          timetypes.WindowsTimeZone
+		expectedName   string
+		expectedOffset int
+	}{
+		"null": {
+			value: timetypes.WindowsTimeZoneNull(),
+		},
+		"unknown": {
+			value: timetypes.WindowsTimeZoneUnknown(),
+		},
+		"value": {
+			value:          testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expectedName:   "America/New_York",
+			expectedOffset: -5 * 60 * 60,
+		},
+		"value-deprecated-iana-name": {
+			value:          testValue(t, timetypes.WindowsTimeZoneString, "India Standard Time"),
+			expectedName:   "Asia/Kolkata",
+			expectedOffset: 5*60*60 + 30*60,
+		},
+		"value-utc": {
+			value:          testValue(t, timetypes.WindowsTimeZoneString, "UTC"),
+			expectedName:   "Etc/UTC",
+			expectedOffset: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Location()
+
+			if testCase.expectedName == "" {
+				if got != nil {
+					t.Fatalf("expected no location, got: %s", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected location %s, got none", testCase.expectedName)
+			}
+
+			if diff := cmp.Diff(got.String(), testCase.expectedName); diff != "" {
+				t.Errorf("unexpected name difference: %s", diff)
+			}
+
+			_, offset := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC).In(got).Zone()
+
+			if diff := cmp.Diff(offset, testCase.expectedOffset); diff != "" {
+				t.Errorf("unexpected offset difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.WindowsTimeZone
+		expected string
+	}{
+		"null": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			expected: "<unknown>",
+		},
+		"value": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expected: "\"Eastern Standard Time\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneTimeZone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.TimeZone
+		expected      timetypes.WindowsTimeZone
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.TimeZoneNull(),
+			expected: timetypes.WindowsTimeZoneNull(),
+		},
+		"unknown": {
+			value:    timetypes.TimeZoneUnknown(),
+			expected: timetypes.WindowsTimeZoneUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/Los_Angeles"),
+			expected: testValue(t, timetypes.WindowsTimeZoneString, "Pacific Standard Time"),
+		},
+		"value-deprecated": {
+			value:    testValue(t, timetypes.TimeZoneString, "Asia/Calcutta"),
+			expected: testValue(t, timetypes.WindowsTimeZoneString, "India Standard Time"),
+		},
+		"value-etc": {
+			value:    testValue(t, timetypes.TimeZoneString, "Etc/GMT+5"),
+			expected: testValue(t, timetypes.WindowsTimeZoneString, "SA Pacific Standard Time"),
+		},
+		"value-no-mapping": {
+			value:    testValue(t, timetypes.TimeZoneString, "Antarctica/Troll"),
+			expected: timetypes.WindowsTimeZoneUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Windows Time Zone Conversion Error",
+					"An unexpected error occurred while converting a time zone to a Windows time zone ID. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Time zone Antarctica/Troll has no Windows time zone ID in the CLDR windowsZones mapping.",
+				),
+			},
+		},
+		"value-non-preferred": {
+			value:    testValue(t, timetypes.TimeZoneString, "America/Detroit"),
+			expected: testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+		},
+		"value-utc": {
+			value:    testValue(t, timetypes.TimeZoneString, "UTC"),
+			expected: testValue(t, timetypes.WindowsTimeZoneString, "UTC"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timetypes.WindowsTimeZoneTimeZone(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneToStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.WindowsTimeZone
+		expected      basetypes.StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			expected: types.StringNull(),
+		},
+		"unknown": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			expected: types.StringUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expected: types.StringValue("Eastern Standard Time"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ToStringValue(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.WindowsTimeZone
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"null": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"value": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+			expected: tftypes.NewValue(tftypes.String, "Eastern Standard Time"),
+		},
+		"value-pacific": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Pacific Standard Time"),
+			expected: tftypes.NewValue(tftypes.String, "Pacific Standard Time"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneToTimeZone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.WindowsTimeZone
+		expected timetypes.TimeZone
+	}{
+		"null": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			expected: timetypes.TimeZoneNull(),
+		},
+		"unknown": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			expected: timetypes.TimeZoneUnknown(),
+		},
+		"value": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Pacific Standard Time"),
+			expected: testValue(t, timetypes.TimeZoneString, "America/Los_Angeles"),
+		},
+		"value-preferred-name": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "FLE Standard Time"),
+			expected: testValue(t, timetypes.TimeZoneString, "Europe/Kyiv"),
+		},
+		"value-utc": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "UTC"),
+			expected: testValue(t, timetypes.TimeZoneString, "Etc/UTC"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ToTimeZone()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.WindowsTimeZone
+		expected attr.Type
+	}{
+		"any": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			expected: timetypes.WindowsTimeZoneType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.Type(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    timetypes.WindowsTimeZone
+		expected string
+	}{
+		"null": {
+			value:    timetypes.WindowsTimeZoneNull(),
+			expected: "",
+		},
+		"unknown": {
+			value:    timetypes.WindowsTimeZoneUnknown(),
+			expected: "",
+		},
+		"value": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "Romance Standard Time"),
+			expected: "Romance Standard Time",
+		},
+		"value-abbreviation": {
+			value:    testValue(t, timetypes.WindowsTimeZoneString, "FLE Standard Time"),
+			expected: "FLE Standard Time",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
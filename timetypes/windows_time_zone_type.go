@@ -0,0 +1,126 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure implementation satisfies expected interfaces.
+var (
+	_ tftypes.AttributePathStepper = WindowsTimeZoneType{}
+	_ attr.Type                    = WindowsTimeZoneType{}
+	_ basetypes.StringTypable      = WindowsTimeZoneType{}
+	_ xattr.TypeWithValidate       = WindowsTimeZoneType{}
+)
+
+// WindowsTimeZoneType implements the attr.Type interface for usage in schema
+// definitions and data models. Values are Windows time zone IDs, such as
+// Pacific Standard Time or W. Europe Standard Time, which are mapped to IANA
+// time zones with the CLDR windowsZones mapping.
+//
+// Time zones are loaded from the host time zone database. Import the tzdata
+// package of this module to embed a copy of the database in the provider,
+// which is used if the host time zone database is unavailable.
+type WindowsTimeZoneType struct{}
+
+// ApplyTerraform5AttributePathStep always returns an error as this type
+// cannot be walked any further.
+func (t WindowsTimeZoneType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is WindowsTimeZoneType.
+func (t WindowsTimeZoneType) Equal(o attr.Type) bool {
+	_, ok := o.(WindowsTimeZoneType)
+
+	return ok
+}
+
+// String returns a human readable string of the type.
+func (t WindowsTimeZoneType) String() string {
+	return "timetypes.WindowsTimeZoneType"
+}
+
+// TerraformType always returns tftypes.String.
+func (t WindowsTimeZoneType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// Validate ensures the value is always a known Windows time zone ID.
+func (t WindowsTimeZoneType) Validate(_ context.Context, terraformValue tftypes.Value, schemaPath path.Path) diag.Diagnostics {
+	if terraformValue.IsNull() || !terraformValue.IsKnown() {
+		return nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				schemaPath,
+				"Invalid Windows Time Zone Terraform Value",
+				"An unexpected error occurred while attempting to read a Windows time zone string from the Terraform value. "+
+					"Please contact the provider developers with the following:\n\n"+
+					"Error: "+err.Error(),
+			),
+		}
+	}
+
+	_, diags := WindowsTimeZoneString(str, schemaPath)
+
+	return diags
+}
+
+// ValueFromString converts the types.String into a value.
+func (t WindowsTimeZoneType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	if in.IsNull() {
+		return WindowsTimeZoneNull(), nil
+	}
+
+	if in.IsUnknown() {
+		return WindowsTimeZoneUnknown(), nil
+	}
+
+	return WindowsTimeZoneString(in.ValueString(), path.Empty())
+}
+
+// ValueFromTerraform converts the tftypes.Value into a value.
+func (t WindowsTimeZoneType) ValueFromTerraform(_ context.Context, terraformValue tftypes.Value) (attr.Value, error) {
+	if terraformValue.IsNull() {
+		return WindowsTimeZoneNull(), nil
+	}
+
+	if !terraformValue.IsKnown() {
+		return WindowsTimeZoneUnknown(), nil
+	}
+
+	var str string
+
+	err := terraformValue.As(&str)
+
+	if err != nil {
+		return WindowsTimeZoneUnknown(), err
+	}
+
+	value, err := loadWindowsTimeZone(str)
+
+	if err != nil {
+		return WindowsTimeZoneUnknown(), err
+	}
+
+	return value, nil
+}
+
+// ValueType returns the associated attr.Value.
+func (t WindowsTimeZoneType) ValueType(_ context.Context) attr.Value {
+	return WindowsTimeZone{}
+}
@@ -0,0 +1,436 @@
+package timetypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bflad/terraform-plugin-framework-type-time/timetypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWindowsTimeZoneTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.WindowsTimeZoneType
+		step          tftypes.AttributePathStep
+		expected      any
+		expectedError error
+	}{
+		"AttributeName": {
+			typ:           timetypes.WindowsTimeZoneType{},
+			step:          tftypes.AttributeName("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.AttributeName to timetypes.WindowsTimeZoneType"),
+		},
+		"ElementKeyInt": {
+			typ:           timetypes.WindowsTimeZoneType{},
+			step:          tftypes.ElementKeyInt(1),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyInt to timetypes.WindowsTimeZoneType"),
+		},
+		"ElementKeyString": {
+			typ:           timetypes.WindowsTimeZoneType{},
+			step:          tftypes.ElementKeyString("test"),
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyString to timetypes.WindowsTimeZoneType"),
+		},
+		"ElementKeyValue": {
+			typ:           timetypes.WindowsTimeZoneType{},
+			step:          tftypes.ElementKeyValue{},
+			expectedError: fmt.Errorf("cannot apply AttributePathStep tftypes.ElementKeyValue to timetypes.WindowsTimeZoneType"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.WindowsTimeZoneType
+		other    attr.Type
+		expected bool
+	}{
+		"nil": {
+			typ:      timetypes.WindowsTimeZoneType{},
+			other:    nil,
+			expected: false,
+		},
+		"timetypes.WindowsTimeZoneType": {
+			typ:      timetypes.WindowsTimeZoneType{},
+			other:    timetypes.WindowsTimeZoneType{},
+			expected: true,
+		},
+		"timetypes.RFC3339Type": {
+			typ:      timetypes.WindowsTimeZoneType{},
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"types.StringType": {
+			typ:      timetypes.WindowsTimeZoneType{},
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.WindowsTimeZoneType
+		expected string
+	}{
+		"any": {
+			typ:      timetypes.WindowsTimeZoneType{},
+			expected: "timetypes.WindowsTimeZoneType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.WindowsTimeZoneType
+		expected tftypes.Type
+	}{
+		"any": {
+			typ:      timetypes.WindowsTimeZoneType{},
+			expected: tftypes.String,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.TerraformType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.WindowsTimeZoneType
+		terraformValue tftypes.Value
+		schemaPath     path.Path
+		expectedDiags  diag.Diagnostics
+	}{
+		"not-string": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Windows Time Zone Terraform Value",
+					"An unexpected error occurred while attempting to read a Windows time zone string from the Terraform value. "+
+						"Please contact the provider developers with the following:\n\n"+
+						"Error: can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+		"string-null": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			schemaPath:     path.Root("test"),
+		},
+		"string-unknown": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-case": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "pacific standard time"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Windows Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Windows time zone ID. "+
+						"The Windows time zone ID format is a time zone name from the Windows registry, such as Pacific Standard Time or W. Europe Standard Time.\n\n"+
+						"Error: unknown Windows time zone ID pacific standard time"+
+						"\n\nDid you mean Pacific Standard Time?",
+				),
+			},
+		},
+		"string-value-iana-name": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Europe/Berlin"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Windows Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Windows time zone ID. "+
+						"The Windows time zone ID format is a time zone name from the Windows registry, such as Pacific Standard Time or W. Europe Standard Time.\n\n"+
+						"Error: unknown Windows time zone ID Europe/Berlin"+
+						"\n\nDid you mean W. Europe Standard Time?",
+				),
+			},
+		},
+		"string-value-invalid": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Nowhere Standard Time"),
+			schemaPath:     path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Windows Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Windows time zone ID. "+
+						"The Windows time zone ID format is a time zone name from the Windows registry, such as Pacific Standard Time or W. Europe Standard Time.\n\n"+
+						"Error: unknown Windows time zone ID Nowhere Standard Time",
+				),
+			},
+		},
+		"string-value-valid": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Pacific Standard Time"),
+			schemaPath:     path.Root("test"),
+		},
+		"string-value-valid-utc": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "UTC-11"),
+			schemaPath:     path.Root("test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.typ.Validate(context.Background(), testCase.terraformValue, testCase.schemaPath)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneTypeValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           timetypes.WindowsTimeZoneType
+		stringValue   basetypes.StringValue
+		expected      basetypes.StringValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			typ:         timetypes.WindowsTimeZoneType{},
+			stringValue: types.StringNull(),
+			expected:    timetypes.WindowsTimeZoneNull(),
+		},
+		"unknown": {
+			typ:         timetypes.WindowsTimeZoneType{},
+			stringValue: types.StringUnknown(),
+			expected:    timetypes.WindowsTimeZoneUnknown(),
+		},
+		"value-invalid": {
+			typ:         timetypes.WindowsTimeZoneType{},
+			stringValue: types.StringValue("Nowhere Standard Time"),
+			expected:    timetypes.WindowsTimeZoneUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Windows Time Zone String Value",
+					"An unexpected error occurred while converting a string value that was expected to be a Windows time zone ID. "+
+						"The Windows time zone ID format is a time zone name from the Windows registry, such as Pacific Standard Time or W. Europe Standard Time.\n\n"+
+						"Error: unknown Windows time zone ID Nowhere Standard Time",
+				),
+			},
+		},
+		"value-valid": {
+			typ:         timetypes.WindowsTimeZoneType{},
+			stringValue: types.StringValue("Romance Standard Time"),
+			expected:    testValue(t, timetypes.WindowsTimeZoneString, "Romance Standard Time"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValueFromString(context.Background(), testCase.stringValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ            timetypes.WindowsTimeZoneType
+		terraformValue tftypes.Value
+		expected       attr.Value
+		expectedError  error
+	}{
+		"not-string": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.Bool, true),
+			expected:       timetypes.WindowsTimeZoneUnknown(),
+			expectedError:  fmt.Errorf("can't unmarshal tftypes.Bool into *string, expected string"),
+		},
+		"string-null": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, nil),
+			expected:       timetypes.WindowsTimeZoneNull(),
+		},
+		"string-unknown": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected:       timetypes.WindowsTimeZoneUnknown(),
+		},
+		"string-value-invalid": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Nowhere Standard Time"),
+			expected:       timetypes.WindowsTimeZoneUnknown(),
+			expectedError:  fmt.Errorf("unknown Windows time zone ID Nowhere Standard Time"),
+		},
+		"string-value-valid": {
+			typ:            timetypes.WindowsTimeZoneType{},
+			terraformValue: tftypes.NewValue(tftypes.String, "Eastern Standard Time"),
+			expected:       testValue(t, timetypes.WindowsTimeZoneString, "Eastern Standard Time"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.terraformValue)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, tfType: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowsTimeZoneTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      timetypes.WindowsTimeZoneType
+		expected attr.Value
+	}{
+		"any": {
+			typ:      timetypes.WindowsTimeZoneType{},
+			expected: timetypes.WindowsTimeZone{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.ValueType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}